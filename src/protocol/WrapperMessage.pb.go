@@ -20,6 +20,368 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DirArchive_Format int32
+
+const (
+	DirArchive_TAR_GZ DirArchive_Format = 0
+	DirArchive_ZIP    DirArchive_Format = 1
+)
+
+// Enum value maps for DirArchive_Format.
+var (
+	DirArchive_Format_name = map[int32]string{
+		0: "TAR_GZ",
+		1: "ZIP",
+	}
+	DirArchive_Format_value = map[string]int32{
+		"TAR_GZ": 0,
+		"ZIP":    1,
+	}
+)
+
+func (x DirArchive_Format) Enum() *DirArchive_Format {
+	p := new(DirArchive_Format)
+	*p = x
+	return p
+}
+
+func (x DirArchive_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DirArchive_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_WrapperMessage_proto_enumTypes[0].Descriptor()
+}
+
+func (DirArchive_Format) Type() protoreflect.EnumType {
+	return &file_WrapperMessage_proto_enumTypes[0]
+}
+
+func (x DirArchive_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DirArchive_Format.Descriptor instead.
+func (DirArchive_Format) EnumDescriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{16, 0}
+}
+
+type DirArchive_Symlinks int32
+
+const (
+	// archived as links
+	DirArchive_PRESERVE DirArchive_Symlinks = 0
+	// archived as the files or directories they point to
+	DirArchive_FOLLOW DirArchive_Symlinks = 1
+	DirArchive_SKIP   DirArchive_Symlinks = 2
+)
+
+// Enum value maps for DirArchive_Symlinks.
+var (
+	DirArchive_Symlinks_name = map[int32]string{
+		0: "PRESERVE",
+		1: "FOLLOW",
+		2: "SKIP",
+	}
+	DirArchive_Symlinks_value = map[string]int32{
+		"PRESERVE": 0,
+		"FOLLOW":   1,
+		"SKIP":     2,
+	}
+)
+
+func (x DirArchive_Symlinks) Enum() *DirArchive_Symlinks {
+	p := new(DirArchive_Symlinks)
+	*p = x
+	return p
+}
+
+func (x DirArchive_Symlinks) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DirArchive_Symlinks) Descriptor() protoreflect.EnumDescriptor {
+	return file_WrapperMessage_proto_enumTypes[1].Descriptor()
+}
+
+func (DirArchive_Symlinks) Type() protoreflect.EnumType {
+	return &file_WrapperMessage_proto_enumTypes[1]
+}
+
+func (x DirArchive_Symlinks) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DirArchive_Symlinks.Descriptor instead.
+func (DirArchive_Symlinks) EnumDescriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{16, 1}
+}
+
+type FileError_Code int32
+
+const (
+	FileError_UNKNOWN           FileError_Code = 0
+	FileError_NOT_FOUND         FileError_Code = 1
+	FileError_ALREADY_EXISTS    FileError_Code = 2
+	FileError_PERMISSION_DENIED FileError_Code = 3
+	// the file changed since it was read
+	FileError_CONFLICT FileError_Code = 4
+	// e.g. a patch beyond the end of the file
+	FileError_INVALID_REQUEST FileError_Code = 5
+	FileError_IS_DIRECTORY    FileError_Code = 6
+	FileError_NOT_EMPTY       FileError_Code = 7
+	FileError_TOO_BIG         FileError_Code = 8
+)
+
+// Enum value maps for FileError_Code.
+var (
+	FileError_Code_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "NOT_FOUND",
+		2: "ALREADY_EXISTS",
+		3: "PERMISSION_DENIED",
+		4: "CONFLICT",
+		5: "INVALID_REQUEST",
+		6: "IS_DIRECTORY",
+		7: "NOT_EMPTY",
+		8: "TOO_BIG",
+	}
+	FileError_Code_value = map[string]int32{
+		"UNKNOWN":           0,
+		"NOT_FOUND":         1,
+		"ALREADY_EXISTS":    2,
+		"PERMISSION_DENIED": 3,
+		"CONFLICT":          4,
+		"INVALID_REQUEST":   5,
+		"IS_DIRECTORY":      6,
+		"NOT_EMPTY":         7,
+		"TOO_BIG":           8,
+	}
+)
+
+func (x FileError_Code) Enum() *FileError_Code {
+	p := new(FileError_Code)
+	*p = x
+	return p
+}
+
+func (x FileError_Code) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileError_Code) Descriptor() protoreflect.EnumDescriptor {
+	return file_WrapperMessage_proto_enumTypes[2].Descriptor()
+}
+
+func (FileError_Code) Type() protoreflect.EnumType {
+	return &file_WrapperMessage_proto_enumTypes[2]
+}
+
+func (x FileError_Code) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FileError_Code.Descriptor instead.
+func (FileError_Code) EnumDescriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{27, 0}
+}
+
+type GitDiff_Base int32
+
+const (
+	// the working tree against HEAD
+	GitDiff_HEAD GitDiff_Base = 0
+	// HEAD against its merge base with the target branch
+	GitDiff_MERGE_BASE GitDiff_Base = 1
+)
+
+// Enum value maps for GitDiff_Base.
+var (
+	GitDiff_Base_name = map[int32]string{
+		0: "HEAD",
+		1: "MERGE_BASE",
+	}
+	GitDiff_Base_value = map[string]int32{
+		"HEAD":       0,
+		"MERGE_BASE": 1,
+	}
+)
+
+func (x GitDiff_Base) Enum() *GitDiff_Base {
+	p := new(GitDiff_Base)
+	*p = x
+	return p
+}
+
+func (x GitDiff_Base) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GitDiff_Base) Descriptor() protoreflect.EnumDescriptor {
+	return file_WrapperMessage_proto_enumTypes[3].Descriptor()
+}
+
+func (GitDiff_Base) Type() protoreflect.EnumType {
+	return &file_WrapperMessage_proto_enumTypes[3]
+}
+
+func (x GitDiff_Base) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GitDiff_Base.Descriptor instead.
+func (GitDiff_Base) EnumDescriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{43, 0}
+}
+
+type ExportPatch_Format int32
+
+const (
+	// `git diff` output, for `git apply`
+	ExportPatch_DIFF ExportPatch_Format = 0
+	// `git format-patch` output, for `git am`
+	ExportPatch_FORMAT_PATCH ExportPatch_Format = 1
+)
+
+// Enum value maps for ExportPatch_Format.
+var (
+	ExportPatch_Format_name = map[int32]string{
+		0: "DIFF",
+		1: "FORMAT_PATCH",
+	}
+	ExportPatch_Format_value = map[string]int32{
+		"DIFF":         0,
+		"FORMAT_PATCH": 1,
+	}
+)
+
+func (x ExportPatch_Format) Enum() *ExportPatch_Format {
+	p := new(ExportPatch_Format)
+	*p = x
+	return p
+}
+
+func (x ExportPatch_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportPatch_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_WrapperMessage_proto_enumTypes[4].Descriptor()
+}
+
+func (ExportPatch_Format) Type() protoreflect.EnumType {
+	return &file_WrapperMessage_proto_enumTypes[4]
+}
+
+func (x ExportPatch_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportPatch_Format.Descriptor instead.
+func (ExportPatch_Format) EnumDescriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{48, 0}
+}
+
+type CommandOutputChunk_Stream int32
+
+const (
+	CommandOutputChunk_STDOUT CommandOutputChunk_Stream = 0
+	CommandOutputChunk_STDERR CommandOutputChunk_Stream = 1
+	// stdout and stderr combined, when the command runs in a pty
+	CommandOutputChunk_TTY CommandOutputChunk_Stream = 2
+)
+
+// Enum value maps for CommandOutputChunk_Stream.
+var (
+	CommandOutputChunk_Stream_name = map[int32]string{
+		0: "STDOUT",
+		1: "STDERR",
+		2: "TTY",
+	}
+	CommandOutputChunk_Stream_value = map[string]int32{
+		"STDOUT": 0,
+		"STDERR": 1,
+		"TTY":    2,
+	}
+)
+
+func (x CommandOutputChunk_Stream) Enum() *CommandOutputChunk_Stream {
+	p := new(CommandOutputChunk_Stream)
+	*p = x
+	return p
+}
+
+func (x CommandOutputChunk_Stream) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommandOutputChunk_Stream) Descriptor() protoreflect.EnumDescriptor {
+	return file_WrapperMessage_proto_enumTypes[5].Descriptor()
+}
+
+func (CommandOutputChunk_Stream) Type() protoreflect.EnumType {
+	return &file_WrapperMessage_proto_enumTypes[5]
+}
+
+func (x CommandOutputChunk_Stream) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommandOutputChunk_Stream.Descriptor instead.
+func (CommandOutputChunk_Stream) EnumDescriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{50, 0}
+}
+
+type Access_Level int32
+
+const (
+	Access_FULL Access_Level = 0
+	// e.g. watch the shared terminal, browse files
+	Access_READ_ONLY Access_Level = 1
+	Access_DISABLED  Access_Level = 2
+)
+
+// Enum value maps for Access_Level.
+var (
+	Access_Level_name = map[int32]string{
+		0: "FULL",
+		1: "READ_ONLY",
+		2: "DISABLED",
+	}
+	Access_Level_value = map[string]int32{
+		"FULL":      0,
+		"READ_ONLY": 1,
+		"DISABLED":  2,
+	}
+)
+
+func (x Access_Level) Enum() *Access_Level {
+	p := new(Access_Level)
+	*p = x
+	return p
+}
+
+func (x Access_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Access_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_WrapperMessage_proto_enumTypes[6].Descriptor()
+}
+
+func (Access_Level) Type() protoreflect.EnumType {
+	return &file_WrapperMessage_proto_enumTypes[6]
+}
+
+func (x Access_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Access_Level.Descriptor instead.
+func (Access_Level) EnumDescriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{61, 0}
+}
+
 // TCP tunneling
 type TcpDialMessage struct {
 	state         protoimpl.MessageState
@@ -415,13 +777,16 @@ func (x *TcpReadResultMessage) GetError() string {
 	return ""
 }
 
-// Terminal Pty
+// Session 0 is the terminal started with the debug session, shared by everyone
+// on the dashboard. Other sessions are opened with TerminalOpen, and belong to
+// the listener which opened them.
 type TerminalData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data      []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	SessionId uint32 `protobuf:"varint,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 }
 
 func (x *TerminalData) Reset() {
@@ -463,12 +828,21 @@ func (x *TerminalData) GetData() []byte {
 	return nil
 }
 
+func (x *TerminalData) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+// Deprecated: only sets the columns, use TerminalResize
 type TerminalWidth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NewWidth uint32 `protobuf:"varint,1,opt,name=new_width,json=newWidth,proto3" json:"new_width,omitempty"`
+	NewWidth  uint32 `protobuf:"varint,1,opt,name=new_width,json=newWidth,proto3" json:"new_width,omitempty"`
+	SessionId uint32 `protobuf:"varint,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 }
 
 func (x *TerminalWidth) Reset() {
@@ -510,16 +884,25 @@ func (x *TerminalWidth) GetNewWidth() uint32 {
 	return 0
 }
 
-type FileRead struct {
+func (x *TerminalWidth) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type TerminalResize struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Rows      uint32 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols      uint32 `protobuf:"varint,2,opt,name=cols,proto3" json:"cols,omitempty"`
+	SessionId uint32 `protobuf:"varint,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 }
 
-func (x *FileRead) Reset() {
-	*x = FileRead{}
+func (x *TerminalResize) Reset() {
+	*x = TerminalResize{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_WrapperMessage_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -527,13 +910,13 @@ func (x *FileRead) Reset() {
 	}
 }
 
-func (x *FileRead) String() string {
+func (x *TerminalResize) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileRead) ProtoMessage() {}
+func (*TerminalResize) ProtoMessage() {}
 
-func (x *FileRead) ProtoReflect() protoreflect.Message {
+func (x *TerminalResize) ProtoReflect() protoreflect.Message {
 	mi := &file_WrapperMessage_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -545,31 +928,42 @@ func (x *FileRead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileRead.ProtoReflect.Descriptor instead.
-func (*FileRead) Descriptor() ([]byte, []int) {
+// Deprecated: Use TerminalResize.ProtoReflect.Descriptor instead.
+func (*TerminalResize) Descriptor() ([]byte, []int) {
 	return file_WrapperMessage_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *FileRead) GetPath() string {
+func (x *TerminalResize) GetRows() uint32 {
 	if x != nil {
-		return x.Path
+		return x.Rows
 	}
-	return ""
+	return 0
 }
 
-type FileReadResult struct {
+func (x *TerminalResize) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *TerminalResize) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type TerminalOpen struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data     []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Path     string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Error    string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	MimeType string `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	SessionId uint32 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 }
 
-func (x *FileReadResult) Reset() {
-	*x = FileReadResult{}
+func (x *TerminalOpen) Reset() {
+	*x = TerminalOpen{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_WrapperMessage_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -577,13 +971,13 @@ func (x *FileReadResult) Reset() {
 	}
 }
 
-func (x *FileReadResult) String() string {
+func (x *TerminalOpen) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileReadResult) ProtoMessage() {}
+func (*TerminalOpen) ProtoMessage() {}
 
-func (x *FileReadResult) ProtoReflect() protoreflect.Message {
+func (x *TerminalOpen) ProtoReflect() protoreflect.Message {
 	mi := &file_WrapperMessage_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -595,49 +989,33 @@ func (x *FileReadResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileReadResult.ProtoReflect.Descriptor instead.
-func (*FileReadResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use TerminalOpen.ProtoReflect.Descriptor instead.
+func (*TerminalOpen) Descriptor() ([]byte, []int) {
 	return file_WrapperMessage_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *FileReadResult) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *FileReadResult) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *FileReadResult) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-func (x *FileReadResult) GetMimeType() string {
+func (x *TerminalOpen) GetSessionId() uint32 {
 	if x != nil {
-		return x.MimeType
+		return x.SessionId
 	}
-	return ""
+	return 0
 }
 
-type FileReadDir struct {
+// An asciicast v2 recording of a terminal session, uploaded when it closes
+// if the wrap client is configured to.
+type TerminalRecording struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	SessionId uint32 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// file name, e.g. wrap-20201016-120000-1.cast
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *FileReadDir) Reset() {
-	*x = FileReadDir{}
+func (x *TerminalRecording) Reset() {
+	*x = TerminalRecording{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_WrapperMessage_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -645,13 +1023,13 @@ func (x *FileReadDir) Reset() {
 	}
 }
 
-func (x *FileReadDir) String() string {
+func (x *TerminalRecording) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileReadDir) ProtoMessage() {}
+func (*TerminalRecording) ProtoMessage() {}
 
-func (x *FileReadDir) ProtoReflect() protoreflect.Message {
+func (x *TerminalRecording) ProtoReflect() protoreflect.Message {
 	mi := &file_WrapperMessage_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -663,32 +1041,44 @@ func (x *FileReadDir) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileReadDir.ProtoReflect.Descriptor instead.
-func (*FileReadDir) Descriptor() ([]byte, []int) {
+// Deprecated: Use TerminalRecording.ProtoReflect.Descriptor instead.
+func (*TerminalRecording) Descriptor() ([]byte, []int) {
 	return file_WrapperMessage_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *FileReadDir) GetPath() string {
+func (x *TerminalRecording) GetSessionId() uint32 {
 	if x != nil {
-		return x.Path
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *TerminalRecording) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type DirEntry struct {
+func (x *TerminalRecording) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Closes a terminal session. From the wrap client, reports that its shell exited.
+// Session 0 closes every session the listener opened, e.g. when it disconnects.
+type TerminalClose struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Path     string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	IsDir    bool   `protobuf:"varint,3,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
-	MimeType string `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	IsEmpty  bool   `protobuf:"varint,5,opt,name=is_empty,json=isEmpty,proto3" json:"is_empty,omitempty"`
+	SessionId uint32 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 }
 
-func (x *DirEntry) Reset() {
-	*x = DirEntry{}
+func (x *TerminalClose) Reset() {
+	*x = TerminalClose{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_WrapperMessage_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -696,13 +1086,13 @@ func (x *DirEntry) Reset() {
 	}
 }
 
-func (x *DirEntry) String() string {
+func (x *TerminalClose) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DirEntry) ProtoMessage() {}
+func (*TerminalClose) ProtoMessage() {}
 
-func (x *DirEntry) ProtoReflect() protoreflect.Message {
+func (x *TerminalClose) ProtoReflect() protoreflect.Message {
 	mi := &file_WrapperMessage_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -714,58 +1104,35 @@ func (x *DirEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DirEntry.ProtoReflect.Descriptor instead.
-func (*DirEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use TerminalClose.ProtoReflect.Descriptor instead.
+func (*TerminalClose) Descriptor() ([]byte, []int) {
 	return file_WrapperMessage_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DirEntry) GetName() string {
+func (x *TerminalClose) GetSessionId() uint32 {
 	if x != nil {
-		return x.Name
+		return x.SessionId
 	}
-	return ""
-}
-
-func (x *DirEntry) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *DirEntry) GetIsDir() bool {
-	if x != nil {
-		return x.IsDir
-	}
-	return false
-}
-
-func (x *DirEntry) GetMimeType() string {
-	if x != nil {
-		return x.MimeType
-	}
-	return ""
-}
-
-func (x *DirEntry) GetIsEmpty() bool {
-	if x != nil {
-		return x.IsEmpty
-	}
-	return false
+	return 0
 }
 
-type FileReadDirResult struct {
+type FileRead struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error string      `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	Path  string      `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Entry []*DirEntry `protobuf:"bytes,3,rep,name=entry,proto3" json:"entry,omitempty"`
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	//
+	//Reads part of the file, which may be bigger than whole files can be. The
+	//length defaults to, and is capped at, the size limit for whole files.
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length uint64 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	// the offset counts back from the end of the file, e.g. to tail a log
+	FromEnd bool `protobuf:"varint,4,opt,name=from_end,json=fromEnd,proto3" json:"from_end,omitempty"`
 }
 
-func (x *FileReadDirResult) Reset() {
-	*x = FileReadDirResult{}
+func (x *FileRead) Reset() {
+	*x = FileRead{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_WrapperMessage_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -773,13 +1140,13 @@ func (x *FileReadDirResult) Reset() {
 	}
 }
 
-func (x *FileReadDirResult) String() string {
+func (x *FileRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileReadDirResult) ProtoMessage() {}
+func (*FileRead) ProtoMessage() {}
 
-func (x *FileReadDirResult) ProtoReflect() protoreflect.Message {
+func (x *FileRead) ProtoReflect() protoreflect.Message {
 	mi := &file_WrapperMessage_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -791,43 +1158,61 @@ func (x *FileReadDirResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileReadDirResult.ProtoReflect.Descriptor instead.
-func (*FileReadDirResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use FileRead.ProtoReflect.Descriptor instead.
+func (*FileRead) Descriptor() ([]byte, []int) {
 	return file_WrapperMessage_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *FileReadDirResult) GetError() string {
+func (x *FileRead) GetPath() string {
 	if x != nil {
-		return x.Error
+		return x.Path
 	}
 	return ""
 }
 
-func (x *FileReadDirResult) GetPath() string {
+func (x *FileRead) GetOffset() uint64 {
 	if x != nil {
-		return x.Path
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *FileReadDirResult) GetEntry() []*DirEntry {
+func (x *FileRead) GetLength() uint64 {
 	if x != nil {
-		return x.Entry
+		return x.Length
 	}
-	return nil
+	return 0
 }
 
-// Initial message
-type Service struct {
+func (x *FileRead) GetFromEnd() bool {
+	if x != nil {
+		return x.FromEnd
+	}
+	return false
+}
+
+type FileReadResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Data     []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Path     string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Error    string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	MimeType string `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// presented in FileWrite to detect conflicting changes: the modification time of
+	// the file on disk, and the hash of data as sent, after secrets were masked
+	ModTime int64  `protobuf:"varint,5,opt,name=mod_time,json=modTime,proto3" json:"mod_time,omitempty"`
+	Sha256  string `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// secrets were masked in data, writes to the file are refused with a CONFLICT
+	Redacted bool `protobuf:"varint,7,opt,name=redacted,proto3" json:"redacted,omitempty"`
+	// where data starts in the file, and the size of the whole file
+	Offset uint64 `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	Size   uint64 `protobuf:"varint,9,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *Service) Reset() {
-	*x = Service{}
+func (x *FileReadResult) Reset() {
+	*x = FileReadResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_WrapperMessage_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -835,13 +1220,13 @@ func (x *Service) Reset() {
 	}
 }
 
-func (x *Service) String() string {
+func (x *FileReadResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Service) ProtoMessage() {}
+func (*FileReadResult) ProtoMessage() {}
 
-func (x *Service) ProtoReflect() protoreflect.Message {
+func (x *FileReadResult) ProtoReflect() protoreflect.Message {
 	mi := &file_WrapperMessage_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -853,42 +1238,90 @@ func (x *Service) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Service.ProtoReflect.Descriptor instead.
-func (*Service) Descriptor() ([]byte, []int) {
+// Deprecated: Use FileReadResult.ProtoReflect.Descriptor instead.
+func (*FileReadResult) Descriptor() ([]byte, []int) {
 	return file_WrapperMessage_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Service) GetAddress() string {
+func (x *FileReadResult) GetData() []byte {
 	if x != nil {
-		return x.Address
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FileReadResult) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
 	return ""
 }
 
-type Hello struct {
+func (x *FileReadResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FileReadResult) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *FileReadResult) GetModTime() int64 {
+	if x != nil {
+		return x.ModTime
+	}
+	return 0
+}
+
+func (x *FileReadResult) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *FileReadResult) GetRedacted() bool {
+	if x != nil {
+		return x.Redacted
+	}
+	return false
+}
+
+func (x *FileReadResult) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FileReadResult) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type FileDownload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommitHash        string     `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
-	BranchName        string     `protobuf:"bytes,2,opt,name=branch_name,json=branchName,proto3" json:"branch_name,omitempty"`
-	PullRequest       string     `protobuf:"bytes,3,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
-	Slug              string     `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
-	Tag               string     `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
-	BuildUrl          string     `protobuf:"bytes,6,opt,name=build_url,json=buildUrl,proto3" json:"build_url,omitempty"`
-	BuildId           string     `protobuf:"bytes,7,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
-	JobId             string     `protobuf:"bytes,8,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	AuthorName        string     `protobuf:"bytes,9,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
-	AuthorAvatar      string     `protobuf:"bytes,10,opt,name=author_avatar,json=authorAvatar,proto3" json:"author_avatar,omitempty"`
-	AuthorEmail       string     `protobuf:"bytes,11,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
-	CiProvider        string     `protobuf:"bytes,12,opt,name=ci_provider,json=ciProvider,proto3" json:"ci_provider,omitempty"`
-	AuthorEmailDomain string     `protobuf:"bytes,13,opt,name=author_email_domain,json=authorEmailDomain,proto3" json:"author_email_domain,omitempty"`
-	WorkingDirectory  string     `protobuf:"bytes,14,opt,name=working_directory,json=workingDirectory,proto3" json:"working_directory,omitempty"`
-	Service           []*Service `protobuf:"bytes,15,rep,name=service,proto3" json:"service,omitempty"`
+	// chosen by the dashboard, identifies the download's chunks
+	DownloadId uint32 `protobuf:"varint,1,opt,name=download_id,json=downloadId,proto3" json:"download_id,omitempty"`
+	Path       string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// where to start, e.g. to resume a download
+	Offset uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// how many chunks may be sent before they're acknowledged, defaults to 16
+	Window uint32 `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func (x *Hello) Reset() {
-	*x = Hello{}
+func (x *FileDownload) Reset() {
+	*x = FileDownload{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_WrapperMessage_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -896,13 +1329,13 @@ func (x *Hello) Reset() {
 	}
 }
 
-func (x *Hello) String() string {
+func (x *FileDownload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Hello) ProtoMessage() {}
+func (*FileDownload) ProtoMessage() {}
 
-func (x *Hello) ProtoReflect() protoreflect.Message {
+func (x *FileDownload) ProtoReflect() protoreflect.Message {
 	mi := &file_WrapperMessage_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,141 +1347,186 @@ func (x *Hello) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Hello.ProtoReflect.Descriptor instead.
-func (*Hello) Descriptor() ([]byte, []int) {
+// Deprecated: Use FileDownload.ProtoReflect.Descriptor instead.
+func (*FileDownload) Descriptor() ([]byte, []int) {
 	return file_WrapperMessage_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Hello) GetCommitHash() string {
+func (x *FileDownload) GetDownloadId() uint32 {
 	if x != nil {
-		return x.CommitHash
+		return x.DownloadId
 	}
-	return ""
+	return 0
 }
 
-func (x *Hello) GetBranchName() string {
+func (x *FileDownload) GetPath() string {
 	if x != nil {
-		return x.BranchName
+		return x.Path
 	}
 	return ""
 }
 
-func (x *Hello) GetPullRequest() string {
+func (x *FileDownload) GetOffset() uint64 {
 	if x != nil {
-		return x.PullRequest
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *Hello) GetSlug() string {
+func (x *FileDownload) GetWindow() uint32 {
 	if x != nil {
-		return x.Slug
+		return x.Window
 	}
-	return ""
+	return 0
 }
 
-func (x *Hello) GetTag() string {
-	if x != nil {
-		return x.Tag
+type FileChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DownloadId uint32 `protobuf:"varint,1,opt,name=download_id,json=downloadId,proto3" json:"download_id,omitempty"`
+	// counts from 1, chunks are sent in order
+	Seq uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	// where data starts in the file
+	Offset uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data   []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// set on the last chunk, which may also have data
+	Done bool `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	// of all the data sent, which differs from the file if secrets were masked
+	Sha256   string `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Redacted bool   `protobuf:"varint,7,opt,name=redacted,proto3" json:"redacted,omitempty"`
+	// set if the download failed, the chunk is the last one
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *FileChunk) Reset() {
+	*x = FileChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Hello) GetBuildUrl() string {
-	if x != nil {
-		return x.BuildUrl
-	}
-	return ""
+func (x *FileChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Hello) GetBuildId() string {
-	if x != nil {
-		return x.BuildId
+func (*FileChunk) ProtoMessage() {}
+
+func (x *FileChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Hello) GetJobId() string {
+// Deprecated: Use FileChunk.ProtoReflect.Descriptor instead.
+func (*FileChunk) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FileChunk) GetDownloadId() uint32 {
 	if x != nil {
-		return x.JobId
+		return x.DownloadId
 	}
-	return ""
+	return 0
 }
 
-func (x *Hello) GetAuthorName() string {
+func (x *FileChunk) GetSeq() uint64 {
 	if x != nil {
-		return x.AuthorName
+		return x.Seq
 	}
-	return ""
+	return 0
 }
 
-func (x *Hello) GetAuthorAvatar() string {
+func (x *FileChunk) GetOffset() uint64 {
 	if x != nil {
-		return x.AuthorAvatar
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *Hello) GetAuthorEmail() string {
+func (x *FileChunk) GetData() []byte {
 	if x != nil {
-		return x.AuthorEmail
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *Hello) GetCiProvider() string {
+func (x *FileChunk) GetDone() bool {
 	if x != nil {
-		return x.CiProvider
+		return x.Done
 	}
-	return ""
+	return false
 }
 
-func (x *Hello) GetAuthorEmailDomain() string {
+func (x *FileChunk) GetSha256() string {
 	if x != nil {
-		return x.AuthorEmailDomain
+		return x.Sha256
 	}
 	return ""
 }
 
-func (x *Hello) GetWorkingDirectory() string {
+func (x *FileChunk) GetRedacted() bool {
 	if x != nil {
-		return x.WorkingDirectory
+		return x.Redacted
 	}
-	return ""
+	return false
 }
 
-func (x *Hello) GetService() []*Service {
+func (x *FileChunk) GetError() string {
 	if x != nil {
-		return x.Service
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type HelloResponse struct {
+type DirArchive struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DashboardUrl string `protobuf:"bytes,1,opt,name=dashboard_url,json=dashboardUrl,proto3" json:"dashboard_url,omitempty"`
-}
-
-func (x *HelloResponse) Reset() {
-	*x = HelloResponse{}
+	// identifies the archive's chunks and progress
+	DownloadId uint32            `protobuf:"varint,1,opt,name=download_id,json=downloadId,proto3" json:"download_id,omitempty"`
+	Path       string            `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Format     DirArchive_Format `protobuf:"varint,3,opt,name=format,proto3,enum=protocol.DirArchive_Format" json:"format,omitempty"`
+	//
+	//Globs matched against paths relative to the directory, or names for
+	//globs without a slash. Everything is archived if there are no includes,
+	//excluded directories are skipped entirely.
+	Include []string `protobuf:"bytes,4,rep,name=include,proto3" json:"include,omitempty"`
+	Exclude []string `protobuf:"bytes,5,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	// the archive fails once the files in it add up to more bytes, defaults to 1 GiB
+	MaxSize  uint64              `protobuf:"varint,6,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	Symlinks DirArchive_Symlinks `protobuf:"varint,7,opt,name=symlinks,proto3,enum=protocol.DirArchive_Symlinks" json:"symlinks,omitempty"`
+	// see FileDownload
+	Window uint32 `protobuf:"varint,8,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (x *DirArchive) Reset() {
+	*x = DirArchive{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_WrapperMessage_proto_msgTypes[15]
+		mi := &file_WrapperMessage_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HelloResponse) String() string {
+func (x *DirArchive) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HelloResponse) ProtoMessage() {}
+func (*DirArchive) ProtoMessage() {}
 
-func (x *HelloResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_WrapperMessage_proto_msgTypes[15]
+func (x *DirArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1059,53 +1537,101 @@ func (x *HelloResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HelloResponse.ProtoReflect.Descriptor instead.
-func (*HelloResponse) Descriptor() ([]byte, []int) {
-	return file_WrapperMessage_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DirArchive.ProtoReflect.Descriptor instead.
+func (*DirArchive) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *HelloResponse) GetDashboardUrl() string {
+func (x *DirArchive) GetDownloadId() uint32 {
 	if x != nil {
-		return x.DashboardUrl
+		return x.DownloadId
+	}
+	return 0
+}
+
+func (x *DirArchive) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
 	return ""
 }
 
-type MessageFromWrapClient struct {
+func (x *DirArchive) GetFormat() DirArchive_Format {
+	if x != nil {
+		return x.Format
+	}
+	return DirArchive_TAR_GZ
+}
+
+func (x *DirArchive) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *DirArchive) GetExclude() []string {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
+func (x *DirArchive) GetMaxSize() uint64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *DirArchive) GetSymlinks() DirArchive_Symlinks {
+	if x != nil {
+		return x.Symlinks
+	}
+	return DirArchive_PRESERVE
+}
+
+func (x *DirArchive) GetWindow() uint32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+// sent periodically while an archive is being made, and once it's done
+type DirArchiveProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Spec:
-	//	*MessageFromWrapClient_Error
-	//	*MessageFromWrapClient_TcpWriteResult
-	//	*MessageFromWrapClient_TcpReadResult
-	//	*MessageFromWrapClient_TcpDialResult
-	//	*MessageFromWrapClient_TerminalData
-	//	*MessageFromWrapClient_Hello
-	//	*MessageFromWrapClient_FileReadResult
-	//	*MessageFromWrapClient_FileReadDirResult
-	Spec       isMessageFromWrapClient_Spec `protobuf_oneof:"spec"`
-	ListenerId uint32                       `protobuf:"varint,10,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	DownloadId uint32 `protobuf:"varint,1,opt,name=download_id,json=downloadId,proto3" json:"download_id,omitempty"`
+	Files      uint64 `protobuf:"varint,2,opt,name=files,proto3" json:"files,omitempty"`
+	// of the files archived so far, before compression
+	Bytes uint64 `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// files which couldn't be read
+	Skipped uint64 `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// the file being archived
+	CurrentPath string `protobuf:"bytes,5,opt,name=current_path,json=currentPath,proto3" json:"current_path,omitempty"`
+	Done        bool   `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
 }
 
-func (x *MessageFromWrapClient) Reset() {
-	*x = MessageFromWrapClient{}
+func (x *DirArchiveProgress) Reset() {
+	*x = DirArchiveProgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_WrapperMessage_proto_msgTypes[16]
+		mi := &file_WrapperMessage_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessageFromWrapClient) String() string {
+func (x *DirArchiveProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageFromWrapClient) ProtoMessage() {}
+func (*DirArchiveProgress) ProtoMessage() {}
 
-func (x *MessageFromWrapClient) ProtoReflect() protoreflect.Message {
-	mi := &file_WrapperMessage_proto_msgTypes[16]
+func (x *DirArchiveProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,174 +1642,210 @@ func (x *MessageFromWrapClient) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageFromWrapClient.ProtoReflect.Descriptor instead.
-func (*MessageFromWrapClient) Descriptor() ([]byte, []int) {
-	return file_WrapperMessage_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DirArchiveProgress.ProtoReflect.Descriptor instead.
+func (*DirArchiveProgress) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{17}
 }
 
-func (m *MessageFromWrapClient) GetSpec() isMessageFromWrapClient_Spec {
-	if m != nil {
-		return m.Spec
+func (x *DirArchiveProgress) GetDownloadId() uint32 {
+	if x != nil {
+		return x.DownloadId
 	}
-	return nil
+	return 0
 }
 
-func (x *MessageFromWrapClient) GetError() string {
-	if x, ok := x.GetSpec().(*MessageFromWrapClient_Error); ok {
-		return x.Error
+func (x *DirArchiveProgress) GetFiles() uint64 {
+	if x != nil {
+		return x.Files
 	}
-	return ""
+	return 0
 }
 
-func (x *MessageFromWrapClient) GetTcpWriteResult() *TcpWriteResultMessage {
-	if x, ok := x.GetSpec().(*MessageFromWrapClient_TcpWriteResult); ok {
-		return x.TcpWriteResult
+func (x *DirArchiveProgress) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
 	}
-	return nil
+	return 0
 }
 
-func (x *MessageFromWrapClient) GetTcpReadResult() *TcpReadResultMessage {
-	if x, ok := x.GetSpec().(*MessageFromWrapClient_TcpReadResult); ok {
-		return x.TcpReadResult
+func (x *DirArchiveProgress) GetSkipped() uint64 {
+	if x != nil {
+		return x.Skipped
 	}
-	return nil
+	return 0
 }
 
-func (x *MessageFromWrapClient) GetTcpDialResult() *TcpDialResultMessage {
-	if x, ok := x.GetSpec().(*MessageFromWrapClient_TcpDialResult); ok {
-		return x.TcpDialResult
+func (x *DirArchiveProgress) GetCurrentPath() string {
+	if x != nil {
+		return x.CurrentPath
 	}
-	return nil
+	return ""
 }
 
-func (x *MessageFromWrapClient) GetTerminalData() *TerminalData {
-	if x, ok := x.GetSpec().(*MessageFromWrapClient_TerminalData); ok {
-		return x.TerminalData
+func (x *DirArchiveProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
 	}
-	return nil
+	return false
 }
 
-func (x *MessageFromWrapClient) GetHello() *Hello {
-	if x, ok := x.GetSpec().(*MessageFromWrapClient_Hello); ok {
-		return x.Hello
-	}
-	return nil
+// acknowledges the chunks received, up to and including seq
+type FileChunkAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DownloadId uint32 `protobuf:"varint,1,opt,name=download_id,json=downloadId,proto3" json:"download_id,omitempty"`
+	Seq        uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	// stops the download
+	Cancel bool `protobuf:"varint,3,opt,name=cancel,proto3" json:"cancel,omitempty"`
 }
 
-func (x *MessageFromWrapClient) GetFileReadResult() *FileReadResult {
-	if x, ok := x.GetSpec().(*MessageFromWrapClient_FileReadResult); ok {
-		return x.FileReadResult
+func (x *FileChunkAck) Reset() {
+	*x = FileChunkAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *MessageFromWrapClient) GetFileReadDirResult() *FileReadDirResult {
-	if x, ok := x.GetSpec().(*MessageFromWrapClient_FileReadDirResult); ok {
-		return x.FileReadDirResult
+func (x *FileChunkAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileChunkAck) ProtoMessage() {}
+
+func (x *FileChunkAck) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *MessageFromWrapClient) GetListenerId() uint32 {
+// Deprecated: Use FileChunkAck.ProtoReflect.Descriptor instead.
+func (*FileChunkAck) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FileChunkAck) GetDownloadId() uint32 {
 	if x != nil {
-		return x.ListenerId
+		return x.DownloadId
 	}
 	return 0
 }
 
-type isMessageFromWrapClient_Spec interface {
-	isMessageFromWrapClient_Spec()
+func (x *FileChunkAck) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
 }
 
-type MessageFromWrapClient_Error struct {
-	Error string `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+func (x *FileChunkAck) GetCancel() bool {
+	if x != nil {
+		return x.Cancel
+	}
+	return false
 }
 
-type MessageFromWrapClient_TcpWriteResult struct {
-	//TCP
-	TcpWriteResult *TcpWriteResultMessage `protobuf:"bytes,2,opt,name=tcp_write_result,json=tcpWriteResult,proto3,oneof"`
-}
+type FileReadDir struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type MessageFromWrapClient_TcpReadResult struct {
-	TcpReadResult *TcpReadResultMessage `protobuf:"bytes,3,opt,name=tcp_read_result,json=tcpReadResult,proto3,oneof"`
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-type MessageFromWrapClient_TcpDialResult struct {
-	TcpDialResult *TcpDialResultMessage `protobuf:"bytes,4,opt,name=tcp_dial_result,json=tcpDialResult,proto3,oneof"`
+func (x *FileReadDir) Reset() {
+	*x = FileReadDir{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type MessageFromWrapClient_TerminalData struct {
-	// Terminal Pty
-	TerminalData *TerminalData `protobuf:"bytes,5,opt,name=terminal_data,json=terminalData,proto3,oneof"`
+func (x *FileReadDir) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type MessageFromWrapClient_Hello struct {
-	// metadata
-	Hello *Hello `protobuf:"bytes,6,opt,name=hello,proto3,oneof"`
-}
+func (*FileReadDir) ProtoMessage() {}
 
-type MessageFromWrapClient_FileReadResult struct {
-	// File browser
-	FileReadResult *FileReadResult `protobuf:"bytes,7,opt,name=file_read_result,json=fileReadResult,proto3,oneof"`
+func (x *FileReadDir) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type MessageFromWrapClient_FileReadDirResult struct {
-	FileReadDirResult *FileReadDirResult `protobuf:"bytes,8,opt,name=file_read_dir_result,json=fileReadDirResult,proto3,oneof"`
+// Deprecated: Use FileReadDir.ProtoReflect.Descriptor instead.
+func (*FileReadDir) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{19}
 }
 
-func (*MessageFromWrapClient_Error) isMessageFromWrapClient_Spec() {}
-
-func (*MessageFromWrapClient_TcpWriteResult) isMessageFromWrapClient_Spec() {}
-
-func (*MessageFromWrapClient_TcpReadResult) isMessageFromWrapClient_Spec() {}
-
-func (*MessageFromWrapClient_TcpDialResult) isMessageFromWrapClient_Spec() {}
-
-func (*MessageFromWrapClient_TerminalData) isMessageFromWrapClient_Spec() {}
-
-func (*MessageFromWrapClient_Hello) isMessageFromWrapClient_Spec() {}
-
-func (*MessageFromWrapClient_FileReadResult) isMessageFromWrapClient_Spec() {}
-
-func (*MessageFromWrapClient_FileReadDirResult) isMessageFromWrapClient_Spec() {}
+func (x *FileReadDir) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
 
-type MessageToWrapClient struct {
+type DirEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Spec:
-	//	*MessageToWrapClient_Error
-	//	*MessageToWrapClient_TcpWriteCall
-	//	*MessageToWrapClient_TcpReadCall
-	//	*MessageToWrapClient_TcpDialCall
-	//	*MessageToWrapClient_TerminalWrite
-	//	*MessageToWrapClient_TerminalWidth
-	//	*MessageToWrapClient_FileRead
-	//	*MessageToWrapClient_FileReadDir
-	//	*MessageToWrapClient_HelloResponse
-	//	*MessageToWrapClient_Close
-	Spec       isMessageToWrapClient_Spec `protobuf_oneof:"spec"`
-	ListenerId uint32                     `protobuf:"varint,11,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path     string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	IsDir    bool   `protobuf:"varint,3,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+	MimeType string `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	IsEmpty  bool   `protobuf:"varint,5,opt,name=is_empty,json=isEmpty,proto3" json:"is_empty,omitempty"`
+	Size     uint64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	// e.g. "-rw-r--r--", or "Lrwxrwxrwx" for symlinks
+	Mode string `protobuf:"bytes,7,opt,name=mode,proto3" json:"mode,omitempty"`
+	// unix nanoseconds
+	ModTime int64 `protobuf:"varint,8,opt,name=mod_time,json=modTime,proto3" json:"mod_time,omitempty"`
+	// user and group names, or ids if they have no name
+	Owner string `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
+	Group string `protobuf:"bytes,10,opt,name=group,proto3" json:"group,omitempty"`
+	// set for symlinks, the entry describes the link itself
+	SymlinkTarget string `protobuf:"bytes,11,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
+	// whether the wrap client can read the file, or list the directory
+	Readable bool `protobuf:"varint,12,opt,name=readable,proto3" json:"readable,omitempty"`
+	// the file's two-letter code in `git status --porcelain`, e.g. " M" or "??", empty if unchanged
+	GitStatus string `protobuf:"bytes,13,opt,name=git_status,json=gitStatus,proto3" json:"git_status,omitempty"`
+	// the directory contains changed or untracked files
+	GitChanges bool `protobuf:"varint,14,opt,name=git_changes,json=gitChanges,proto3" json:"git_changes,omitempty"`
 }
 
-func (x *MessageToWrapClient) Reset() {
-	*x = MessageToWrapClient{}
+func (x *DirEntry) Reset() {
+	*x = DirEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_WrapperMessage_proto_msgTypes[17]
+		mi := &file_WrapperMessage_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessageToWrapClient) String() string {
+func (x *DirEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageToWrapClient) ProtoMessage() {}
+func (*DirEntry) ProtoMessage() {}
 
-func (x *MessageToWrapClient) ProtoReflect() protoreflect.Message {
-	mi := &file_WrapperMessage_proto_msgTypes[17]
+func (x *DirEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1294,427 +1856,5617 @@ func (x *MessageToWrapClient) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageToWrapClient.ProtoReflect.Descriptor instead.
-func (*MessageToWrapClient) Descriptor() ([]byte, []int) {
-	return file_WrapperMessage_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DirEntry.ProtoReflect.Descriptor instead.
+func (*DirEntry) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{20}
 }
 
-func (m *MessageToWrapClient) GetSpec() isMessageToWrapClient_Spec {
-	if m != nil {
-		return m.Spec
+func (x *DirEntry) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *MessageToWrapClient) GetError() string {
-	if x, ok := x.GetSpec().(*MessageToWrapClient_Error); ok {
-		return x.Error
+func (x *DirEntry) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
 	return ""
 }
 
-func (x *MessageToWrapClient) GetTcpWriteCall() *TcpWriteMessage {
-	if x, ok := x.GetSpec().(*MessageToWrapClient_TcpWriteCall); ok {
-		return x.TcpWriteCall
+func (x *DirEntry) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
 	}
-	return nil
+	return false
 }
 
-func (x *MessageToWrapClient) GetTcpReadCall() *TcpReadMessage {
-	if x, ok := x.GetSpec().(*MessageToWrapClient_TcpReadCall); ok {
-		return x.TcpReadCall
+func (x *DirEntry) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
 	}
-	return nil
+	return ""
 }
 
-func (x *MessageToWrapClient) GetTcpDialCall() *TcpDialMessage {
-	if x, ok := x.GetSpec().(*MessageToWrapClient_TcpDialCall); ok {
-		return x.TcpDialCall
+func (x *DirEntry) GetIsEmpty() bool {
+	if x != nil {
+		return x.IsEmpty
 	}
-	return nil
+	return false
 }
 
-func (x *MessageToWrapClient) GetTerminalWrite() *TerminalData {
-	if x, ok := x.GetSpec().(*MessageToWrapClient_TerminalWrite); ok {
-		return x.TerminalWrite
+func (x *DirEntry) GetSize() uint64 {
+	if x != nil {
+		return x.Size
 	}
-	return nil
+	return 0
 }
 
-func (x *MessageToWrapClient) GetTerminalWidth() *TerminalWidth {
-	if x, ok := x.GetSpec().(*MessageToWrapClient_TerminalWidth); ok {
-		return x.TerminalWidth
+func (x *DirEntry) GetMode() string {
+	if x != nil {
+		return x.Mode
 	}
-	return nil
+	return ""
 }
 
-func (x *MessageToWrapClient) GetFileRead() *FileRead {
-	if x, ok := x.GetSpec().(*MessageToWrapClient_FileRead); ok {
-		return x.FileRead
+func (x *DirEntry) GetModTime() int64 {
+	if x != nil {
+		return x.ModTime
 	}
-	return nil
+	return 0
 }
 
-func (x *MessageToWrapClient) GetFileReadDir() *FileReadDir {
-	if x, ok := x.GetSpec().(*MessageToWrapClient_FileReadDir); ok {
-		return x.FileReadDir
+func (x *DirEntry) GetOwner() string {
+	if x != nil {
+		return x.Owner
 	}
-	return nil
+	return ""
 }
 
-func (x *MessageToWrapClient) GetHelloResponse() *HelloResponse {
-	if x, ok := x.GetSpec().(*MessageToWrapClient_HelloResponse); ok {
-		return x.HelloResponse
+func (x *DirEntry) GetGroup() string {
+	if x != nil {
+		return x.Group
 	}
-	return nil
+	return ""
 }
 
-func (x *MessageToWrapClient) GetClose() bool {
-	if x, ok := x.GetSpec().(*MessageToWrapClient_Close); ok {
-		return x.Close
+func (x *DirEntry) GetSymlinkTarget() string {
+	if x != nil {
+		return x.SymlinkTarget
+	}
+	return ""
+}
+
+func (x *DirEntry) GetReadable() bool {
+	if x != nil {
+		return x.Readable
 	}
 	return false
 }
 
-func (x *MessageToWrapClient) GetListenerId() uint32 {
+func (x *DirEntry) GetGitStatus() string {
 	if x != nil {
-		return x.ListenerId
+		return x.GitStatus
 	}
-	return 0
+	return ""
 }
 
-type isMessageToWrapClient_Spec interface {
-	isMessageToWrapClient_Spec()
+func (x *DirEntry) GetGitChanges() bool {
+	if x != nil {
+		return x.GitChanges
+	}
+	return false
 }
 
-type MessageToWrapClient_Error struct {
-	Error string `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+type FileReadDirResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string      `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Path  string      `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Entry []*DirEntry `protobuf:"bytes,3,rep,name=entry,proto3" json:"entry,omitempty"`
 }
 
-type MessageToWrapClient_TcpWriteCall struct {
-	// TCP
-	TcpWriteCall *TcpWriteMessage `protobuf:"bytes,2,opt,name=tcp_write_call,json=tcpWriteCall,proto3,oneof"`
+func (x *FileReadDirResult) Reset() {
+	*x = FileReadDirResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type MessageToWrapClient_TcpReadCall struct {
-	TcpReadCall *TcpReadMessage `protobuf:"bytes,3,opt,name=tcp_read_call,json=tcpReadCall,proto3,oneof"`
+func (x *FileReadDirResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type MessageToWrapClient_TcpDialCall struct {
-	TcpDialCall *TcpDialMessage `protobuf:"bytes,4,opt,name=tcp_dial_call,json=tcpDialCall,proto3,oneof"`
+func (*FileReadDirResult) ProtoMessage() {}
+
+func (x *FileReadDirResult) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type MessageToWrapClient_TerminalWrite struct {
-	// Terminal Pty
-	TerminalWrite *TerminalData `protobuf:"bytes,5,opt,name=terminal_write,json=terminalWrite,proto3,oneof"`
+// Deprecated: Use FileReadDirResult.ProtoReflect.Descriptor instead.
+func (*FileReadDirResult) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{21}
 }
 
-type MessageToWrapClient_TerminalWidth struct {
-	TerminalWidth *TerminalWidth `protobuf:"bytes,6,opt,name=terminal_width,json=terminalWidth,proto3,oneof"`
+func (x *FileReadDirResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-type MessageToWrapClient_FileRead struct {
-	// File browser
-	FileRead *FileRead `protobuf:"bytes,7,opt,name=file_read,json=fileRead,proto3,oneof"`
+func (x *FileReadDirResult) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
 }
 
-type MessageToWrapClient_FileReadDir struct {
-	FileReadDir *FileReadDir `protobuf:"bytes,8,opt,name=file_read_dir,json=fileReadDir,proto3,oneof"`
+func (x *FileReadDirResult) GetEntry() []*DirEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
 }
 
-type MessageToWrapClient_HelloResponse struct {
-	// metadata
-	HelloResponse *HelloResponse `protobuf:"bytes,9,opt,name=hello_response,json=helloResponse,proto3,oneof"`
+// replaces a file's contents, or a byte range of them
+type FileWrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Path      string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Data      []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// replace length bytes at offset with data, instead of the whole file
+	Patch  bool   `protobuf:"varint,4,opt,name=patch,proto3" json:"patch,omitempty"`
+	Offset uint64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length uint64 `protobuf:"varint,6,opt,name=length,proto3" json:"length,omitempty"`
+	// if set, the write fails with a CONFLICT error unless the file still has this
+	// modification time (unix nanoseconds) and SHA-256 hash, see FileReadResult
+	ExpectedModTime int64  `protobuf:"varint,7,opt,name=expected_mod_time,json=expectedModTime,proto3" json:"expected_mod_time,omitempty"`
+	ExpectedSha256  string `protobuf:"bytes,8,opt,name=expected_sha256,json=expectedSha256,proto3" json:"expected_sha256,omitempty"`
+}
+
+func (x *FileWrite) Reset() {
+	*x = FileWrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type MessageToWrapClient_Close struct {
-	// signal to shut down immediately
-	Close bool `protobuf:"varint,10,opt,name=close,proto3,oneof"`
+func (x *FileWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageToWrapClient_Error) isMessageToWrapClient_Spec() {}
+func (*FileWrite) ProtoMessage() {}
 
-func (*MessageToWrapClient_TcpWriteCall) isMessageToWrapClient_Spec() {}
+func (x *FileWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*MessageToWrapClient_TcpReadCall) isMessageToWrapClient_Spec() {}
+// Deprecated: Use FileWrite.ProtoReflect.Descriptor instead.
+func (*FileWrite) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{22}
+}
 
-func (*MessageToWrapClient_TcpDialCall) isMessageToWrapClient_Spec() {}
+func (x *FileWrite) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
 
-func (*MessageToWrapClient_TerminalWrite) isMessageToWrapClient_Spec() {}
+func (x *FileWrite) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
 
-func (*MessageToWrapClient_TerminalWidth) isMessageToWrapClient_Spec() {}
+func (x *FileWrite) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
 
-func (*MessageToWrapClient_FileRead) isMessageToWrapClient_Spec() {}
+func (x *FileWrite) GetPatch() bool {
+	if x != nil {
+		return x.Patch
+	}
+	return false
+}
 
-func (*MessageToWrapClient_FileReadDir) isMessageToWrapClient_Spec() {}
+func (x *FileWrite) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
 
-func (*MessageToWrapClient_HelloResponse) isMessageToWrapClient_Spec() {}
+func (x *FileWrite) GetLength() uint64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
 
-func (*MessageToWrapClient_Close) isMessageToWrapClient_Spec() {}
+func (x *FileWrite) GetExpectedModTime() int64 {
+	if x != nil {
+		return x.ExpectedModTime
+	}
+	return 0
+}
 
-var File_WrapperMessage_proto protoreflect.FileDescriptor
+func (x *FileWrite) GetExpectedSha256() string {
+	if x != nil {
+		return x.ExpectedSha256
+	}
+	return ""
+}
 
-var file_WrapperMessage_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x22, 0x4f, 0x0a, 0x0e, 0x54, 0x63, 0x70, 0x44, 0x69, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0x6b, 0x0a, 0x14, 0x54, 0x63, 0x70, 0x44, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x67,
-	0x0a, 0x0f, 0x54, 0x63, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x94, 0x01, 0x0a, 0x15, 0x54, 0x63, 0x70, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69,
-	0x74, 0x74, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x73,
-	0x0a, 0x0e, 0x54, 0x63, 0x70, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x14, 0x54, 0x63, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x61,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x22, 0x0a, 0x0c, 0x54, 0x65, 0x72, 0x6d, 0x69,
-	0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2c, 0x0a, 0x0d, 0x54,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09,
-	0x6e, 0x65, 0x77, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x08, 0x6e, 0x65, 0x77, 0x57, 0x69, 0x64, 0x74, 0x68, 0x22, 0x1e, 0x0a, 0x08, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x6b, 0x0a, 0x0e, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69,
-	0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x21, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x61, 0x64, 0x44, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x81, 0x01, 0x0a, 0x08, 0x44, 0x69,
-	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x15,
-	0x0a, 0x06, 0x69, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x69, 0x73, 0x44, 0x69, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x67, 0x0a,
-	0x11, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x05,
-	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x23, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf5, 0x03, 0x0a, 0x05,
-	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x6c, 0x6c, 0x5f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
-	0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c,
-	0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x10,
-	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
-	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a,
-	0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
-	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x61, 0x76, 0x61, 0x74, 0x61,
-	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41,
-	0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x69, 0x5f, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
-	0x69, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x77, 0x6f, 0x72,
-	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x22, 0x34, 0x0a, 0x0d, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x73,
-	0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x72, 0x6c, 0x22, 0xb7, 0x04, 0x0a, 0x15, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4b, 0x0a, 0x10, 0x74,
-	0x63, 0x70, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2e, 0x54, 0x63, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x48, 0x0a, 0x0f, 0x74, 0x63, 0x70, 0x5f,
-	0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x70,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x63, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x48, 0x0a, 0x0f, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x5f, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x70, 0x44, 0x69, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x74,
-	0x63, 0x70, 0x44, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x0d,
-	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c, 0x74,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x05, 0x68,
-	0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x68,
-	0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x44, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x61,
-	0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4e, 0x0a, 0x14, 0x66, 0x69,
-	0x6c, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x61,
-	0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x73,
-	0x70, 0x65, 0x63, 0x22, 0xe6, 0x04, 0x0a, 0x13, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54,
-	0x6f, 0x57, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x3e, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65,
-	0x61, 0x64, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x70, 0x52, 0x65, 0x61, 0x64,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x52, 0x65,
-	0x61, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x3e, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x69,
-	0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x70, 0x44, 0x69, 0x61, 0x6c,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x44, 0x69,
-	0x61, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x3f, 0x0a, 0x0e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x61, 0x6c, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x65, 0x72, 0x6d, 0x69,
-	0x6e, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69,
-	0x6e, 0x61, 0x6c, 0x57, 0x69, 0x64, 0x74, 0x68, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x09, 0x66, 0x69, 0x6c,
-	0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64,
-	0x48, 0x00, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x12, 0x3b, 0x0a, 0x0d,
-	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x66, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x12, 0x40, 0x0a, 0x0e, 0x68, 0x65, 0x6c,
-	0x6c, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x48, 0x65, 0x6c,
-	0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x68, 0x65,
-	0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x05, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c,
-	0x6f, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x49, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x42, 0x0c, 0x5a, 0x0a,
-	0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+// creates a new file, failing if it exists
+type FileCreate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Path      string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Data      []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// permission bits, defaults to 0644
+	Mode uint32 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
 }
 
-var (
-	file_WrapperMessage_proto_rawDescOnce sync.Once
-	file_WrapperMessage_proto_rawDescData = file_WrapperMessage_proto_rawDesc
-)
+func (x *FileCreate) Reset() {
+	*x = FileCreate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_WrapperMessage_proto_rawDescGZIP() []byte {
-	file_WrapperMessage_proto_rawDescOnce.Do(func() {
-		file_WrapperMessage_proto_rawDescData = protoimpl.X.CompressGZIP(file_WrapperMessage_proto_rawDescData)
-	})
-	return file_WrapperMessage_proto_rawDescData
+func (x *FileCreate) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_WrapperMessage_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
-var file_WrapperMessage_proto_goTypes = []interface{}{
-	(*TcpDialMessage)(nil),        // 0: protocol.TcpDialMessage
-	(*TcpDialResultMessage)(nil),  // 1: protocol.TcpDialResultMessage
-	(*TcpWriteMessage)(nil),       // 2: protocol.TcpWriteMessage
-	(*TcpWriteResultMessage)(nil), // 3: protocol.TcpWriteResultMessage
-	(*TcpReadMessage)(nil),        // 4: protocol.TcpReadMessage
-	(*TcpReadResultMessage)(nil),  // 5: protocol.TcpReadResultMessage
-	(*TerminalData)(nil),          // 6: protocol.TerminalData
-	(*TerminalWidth)(nil),         // 7: protocol.TerminalWidth
-	(*FileRead)(nil),              // 8: protocol.FileRead
-	(*FileReadResult)(nil),        // 9: protocol.FileReadResult
-	(*FileReadDir)(nil),           // 10: protocol.FileReadDir
-	(*DirEntry)(nil),              // 11: protocol.DirEntry
-	(*FileReadDirResult)(nil),     // 12: protocol.FileReadDirResult
-	(*Service)(nil),               // 13: protocol.Service
-	(*Hello)(nil),                 // 14: protocol.Hello
-	(*HelloResponse)(nil),         // 15: protocol.HelloResponse
-	(*MessageFromWrapClient)(nil), // 16: protocol.MessageFromWrapClient
-	(*MessageToWrapClient)(nil),   // 17: protocol.MessageToWrapClient
+func (*FileCreate) ProtoMessage() {}
+
+func (x *FileCreate) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_WrapperMessage_proto_depIdxs = []int32{
-	11, // 0: protocol.FileReadDirResult.entry:type_name -> protocol.DirEntry
-	13, // 1: protocol.Hello.service:type_name -> protocol.Service
-	3,  // 2: protocol.MessageFromWrapClient.tcp_write_result:type_name -> protocol.TcpWriteResultMessage
-	5,  // 3: protocol.MessageFromWrapClient.tcp_read_result:type_name -> protocol.TcpReadResultMessage
-	1,  // 4: protocol.MessageFromWrapClient.tcp_dial_result:type_name -> protocol.TcpDialResultMessage
-	6,  // 5: protocol.MessageFromWrapClient.terminal_data:type_name -> protocol.TerminalData
-	14, // 6: protocol.MessageFromWrapClient.hello:type_name -> protocol.Hello
-	9,  // 7: protocol.MessageFromWrapClient.file_read_result:type_name -> protocol.FileReadResult
-	12, // 8: protocol.MessageFromWrapClient.file_read_dir_result:type_name -> protocol.FileReadDirResult
-	2,  // 9: protocol.MessageToWrapClient.tcp_write_call:type_name -> protocol.TcpWriteMessage
-	4,  // 10: protocol.MessageToWrapClient.tcp_read_call:type_name -> protocol.TcpReadMessage
-	0,  // 11: protocol.MessageToWrapClient.tcp_dial_call:type_name -> protocol.TcpDialMessage
-	6,  // 12: protocol.MessageToWrapClient.terminal_write:type_name -> protocol.TerminalData
-	7,  // 13: protocol.MessageToWrapClient.terminal_width:type_name -> protocol.TerminalWidth
-	8,  // 14: protocol.MessageToWrapClient.file_read:type_name -> protocol.FileRead
-	10, // 15: protocol.MessageToWrapClient.file_read_dir:type_name -> protocol.FileReadDir
-	15, // 16: protocol.MessageToWrapClient.hello_response:type_name -> protocol.HelloResponse
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+
+// Deprecated: Use FileCreate.ProtoReflect.Descriptor instead.
+func (*FileCreate) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{23}
 }
 
-func init() { file_WrapperMessage_proto_init() }
-func file_WrapperMessage_proto_init() {
-	if File_WrapperMessage_proto != nil {
-		return
+func (x *FileCreate) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_WrapperMessage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TcpDialMessage); i {
+	return 0
+}
+
+func (x *FileCreate) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileCreate) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FileCreate) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type FileDelete struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Path      string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// required for directories which aren't empty
+	Recursive bool `protobuf:"varint,3,opt,name=recursive,proto3" json:"recursive,omitempty"`
+}
+
+func (x *FileDelete) Reset() {
+	*x = FileDelete{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileDelete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileDelete) ProtoMessage() {}
+
+func (x *FileDelete) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileDelete.ProtoReflect.Descriptor instead.
+func (*FileDelete) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FileDelete) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *FileDelete) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileDelete) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+type FileRename struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Path      string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	NewPath   string `protobuf:"bytes,3,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"`
+	// replace new_path if it exists
+	Overwrite bool `protobuf:"varint,4,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+}
+
+func (x *FileRename) Reset() {
+	*x = FileRename{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileRename) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileRename) ProtoMessage() {}
+
+func (x *FileRename) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileRename.ProtoReflect.Descriptor instead.
+func (*FileRename) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FileRename) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *FileRename) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileRename) GetNewPath() string {
+	if x != nil {
+		return x.NewPath
+	}
+	return ""
+}
+
+func (x *FileRename) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type MakeDir struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Path      string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// create missing parent directories too
+	Parents bool `protobuf:"varint,3,opt,name=parents,proto3" json:"parents,omitempty"`
+	// permission bits, defaults to 0755
+	Mode uint32 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+}
+
+func (x *MakeDir) Reset() {
+	*x = MakeDir{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MakeDir) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeDir) ProtoMessage() {}
+
+func (x *MakeDir) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeDir.ProtoReflect.Descriptor instead.
+func (*MakeDir) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MakeDir) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *MakeDir) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *MakeDir) GetParents() bool {
+	if x != nil {
+		return x.Parents
+	}
+	return false
+}
+
+func (x *MakeDir) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type FileError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    FileError_Code `protobuf:"varint,1,opt,name=code,proto3,enum=protocol.FileError_Code" json:"code,omitempty"`
+	Message string         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *FileError) Reset() {
+	*x = FileError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileError) ProtoMessage() {}
+
+func (x *FileError) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileError.ProtoReflect.Descriptor instead.
+func (*FileError) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FileError) GetCode() FileError_Code {
+	if x != nil {
+		return x.Code
+	}
+	return FileError_UNKNOWN
+}
+
+func (x *FileError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type FileEditResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// the path which was written, created, deleted, renamed to or made
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// unset if the request succeeded
+	Error *FileError `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// of the file after a write or create, for the next FileWrite
+	ModTime int64  `protobuf:"varint,4,opt,name=mod_time,json=modTime,proto3" json:"mod_time,omitempty"`
+	Sha256  string `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (x *FileEditResult) Reset() {
+	*x = FileEditResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileEditResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileEditResult) ProtoMessage() {}
+
+func (x *FileEditResult) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileEditResult.ProtoReflect.Descriptor instead.
+func (*FileEditResult) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FileEditResult) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *FileEditResult) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileEditResult) GetError() *FileError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *FileEditResult) GetModTime() int64 {
+	if x != nil {
+		return x.ModTime
+	}
+	return 0
+}
+
+func (x *FileEditResult) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type FileUploadBegin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// chosen by the dashboard, identifies the upload's chunks
+	UploadId uint32 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Path     string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// permission bits, defaults to those of the replaced file, or 0644
+	Mode uint32 `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	// the upload fails unless exactly this many bytes are sent
+	Size uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// replace the file at path if it exists
+	Overwrite bool `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+}
+
+func (x *FileUploadBegin) Reset() {
+	*x = FileUploadBegin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileUploadBegin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUploadBegin) ProtoMessage() {}
+
+func (x *FileUploadBegin) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUploadBegin.ProtoReflect.Descriptor instead.
+func (*FileUploadBegin) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FileUploadBegin) GetUploadId() uint32 {
+	if x != nil {
+		return x.UploadId
+	}
+	return 0
+}
+
+func (x *FileUploadBegin) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileUploadBegin) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *FileUploadBegin) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileUploadBegin) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type FileUploadChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadId uint32 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// counts from 1, chunks must be sent in order
+	Seq  uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *FileUploadChunk) Reset() {
+	*x = FileUploadChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileUploadChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUploadChunk) ProtoMessage() {}
+
+func (x *FileUploadChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUploadChunk.ProtoReflect.Descriptor instead.
+func (*FileUploadChunk) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FileUploadChunk) GetUploadId() uint32 {
+	if x != nil {
+		return x.UploadId
+	}
+	return 0
+}
+
+func (x *FileUploadChunk) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *FileUploadChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// moves the uploaded file into place, if its SHA-256 hash matches
+type FileUploadCommit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadId uint32 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Sha256   string `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (x *FileUploadCommit) Reset() {
+	*x = FileUploadCommit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileUploadCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUploadCommit) ProtoMessage() {}
+
+func (x *FileUploadCommit) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUploadCommit.ProtoReflect.Descriptor instead.
+func (*FileUploadCommit) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FileUploadCommit) GetUploadId() uint32 {
+	if x != nil {
+		return x.UploadId
+	}
+	return 0
+}
+
+func (x *FileUploadCommit) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type FileUploadAbort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadId uint32 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+}
+
+func (x *FileUploadAbort) Reset() {
+	*x = FileUploadAbort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileUploadAbort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUploadAbort) ProtoMessage() {}
+
+func (x *FileUploadAbort) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUploadAbort.ProtoReflect.Descriptor instead.
+func (*FileUploadAbort) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FileUploadAbort) GetUploadId() uint32 {
+	if x != nil {
+		return x.UploadId
+	}
+	return 0
+}
+
+type FileUploadResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadId uint32 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Path     string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// the upload was aborted because of this error
+	Error *FileError `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// the file is in place
+	Committed bool `protobuf:"varint,4,opt,name=committed,proto3" json:"committed,omitempty"`
+	Aborted   bool `protobuf:"varint,5,opt,name=aborted,proto3" json:"aborted,omitempty"`
+}
+
+func (x *FileUploadResult) Reset() {
+	*x = FileUploadResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileUploadResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUploadResult) ProtoMessage() {}
+
+func (x *FileUploadResult) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUploadResult.ProtoReflect.Descriptor instead.
+func (*FileUploadResult) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FileUploadResult) GetUploadId() uint32 {
+	if x != nil {
+		return x.UploadId
+	}
+	return 0
+}
+
+func (x *FileUploadResult) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileUploadResult) GetError() *FileError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *FileUploadResult) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *FileUploadResult) GetAborted() bool {
+	if x != nil {
+		return x.Aborted
+	}
+	return false
+}
+
+type FileSearch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// chosen by the dashboard, identifies the search's results
+	SearchId uint32 `protobuf:"varint,1,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"`
+	// the directory to search in
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// a glob, matched against names, or against relative paths if it has a slash
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// match names containing the pattern's characters in order instead
+	Fuzzy bool `protobuf:"varint,4,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`
+	// defaults to 1000
+	MaxResults uint32 `protobuf:"varint,5,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	// also search files ignored by .gitignore files
+	IncludeIgnored bool `protobuf:"varint,6,opt,name=include_ignored,json=includeIgnored,proto3" json:"include_ignored,omitempty"`
+}
+
+func (x *FileSearch) Reset() {
+	*x = FileSearch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileSearch) ProtoMessage() {}
+
+func (x *FileSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileSearch.ProtoReflect.Descriptor instead.
+func (*FileSearch) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FileSearch) GetSearchId() uint32 {
+	if x != nil {
+		return x.SearchId
+	}
+	return 0
+}
+
+func (x *FileSearch) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileSearch) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *FileSearch) GetFuzzy() bool {
+	if x != nil {
+		return x.Fuzzy
+	}
+	return false
+}
+
+func (x *FileSearch) GetMaxResults() uint32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *FileSearch) GetIncludeIgnored() bool {
+	if x != nil {
+		return x.IncludeIgnored
+	}
+	return false
+}
+
+type FileGrep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SearchId uint32 `protobuf:"varint,1,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"`
+	Path     string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// a regular expression in Go's syntax
+	Pattern    string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	IgnoreCase bool   `protobuf:"varint,4,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
+	// lines shown before and after each matching line
+	ContextLines uint32 `protobuf:"varint,5,opt,name=context_lines,json=contextLines,proto3" json:"context_lines,omitempty"`
+	// matching lines, defaults to 1000
+	MaxResults     uint32 `protobuf:"varint,6,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	IncludeIgnored bool   `protobuf:"varint,7,opt,name=include_ignored,json=includeIgnored,proto3" json:"include_ignored,omitempty"`
+	// only search files matching any of these globs, see FileSearch
+	Include []string `protobuf:"bytes,8,rep,name=include,proto3" json:"include,omitempty"`
+}
+
+func (x *FileGrep) Reset() {
+	*x = FileGrep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileGrep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileGrep) ProtoMessage() {}
+
+func (x *FileGrep) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileGrep.ProtoReflect.Descriptor instead.
+func (*FileGrep) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FileGrep) GetSearchId() uint32 {
+	if x != nil {
+		return x.SearchId
+	}
+	return 0
+}
+
+func (x *FileGrep) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileGrep) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *FileGrep) GetIgnoreCase() bool {
+	if x != nil {
+		return x.IgnoreCase
+	}
+	return false
+}
+
+func (x *FileGrep) GetContextLines() uint32 {
+	if x != nil {
+		return x.ContextLines
+	}
+	return 0
+}
+
+func (x *FileGrep) GetMaxResults() uint32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *FileGrep) GetIncludeIgnored() bool {
+	if x != nil {
+		return x.IncludeIgnored
+	}
+	return false
+}
+
+func (x *FileGrep) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+type FileSearchCancel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SearchId uint32 `protobuf:"varint,1,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"`
+}
+
+func (x *FileSearchCancel) Reset() {
+	*x = FileSearchCancel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileSearchCancel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileSearchCancel) ProtoMessage() {}
+
+func (x *FileSearchCancel) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileSearchCancel.ProtoReflect.Descriptor instead.
+func (*FileSearchCancel) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FileSearchCancel) GetSearchId() uint32 {
+	if x != nil {
+		return x.SearchId
+	}
+	return 0
+}
+
+// where a pattern matched in a line
+type TextRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start uint32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   uint32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *TextRange) Reset() {
+	*x = TextRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextRange) ProtoMessage() {}
+
+func (x *TextRange) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextRange.ProtoReflect.Descriptor instead.
+func (*TextRange) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TextRange) GetStart() uint32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TextRange) GetEnd() uint32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type FileMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	IsDir bool   `protobuf:"varint,2,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+	// fuzzy matches only, higher is better
+	Score int32 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	// grep matches only, lines count from 1, in the file as it's read with secrets masked
+	LineNumber uint32       `protobuf:"varint,4,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`
+	Line       string       `protobuf:"bytes,5,opt,name=line,proto3" json:"line,omitempty"`
+	Range      []*TextRange `protobuf:"bytes,6,rep,name=range,proto3" json:"range,omitempty"`
+	Before     []string     `protobuf:"bytes,7,rep,name=before,proto3" json:"before,omitempty"`
+	After      []string     `protobuf:"bytes,8,rep,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *FileMatch) Reset() {
+	*x = FileMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileMatch) ProtoMessage() {}
+
+func (x *FileMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileMatch.ProtoReflect.Descriptor instead.
+func (*FileMatch) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FileMatch) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileMatch) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+func (x *FileMatch) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *FileMatch) GetLineNumber() uint32 {
+	if x != nil {
+		return x.LineNumber
+	}
+	return 0
+}
+
+func (x *FileMatch) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *FileMatch) GetRange() []*TextRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *FileMatch) GetBefore() []string {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *FileMatch) GetAfter() []string {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type FileSearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SearchId uint32       `protobuf:"varint,1,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"`
+	Match    []*FileMatch `protobuf:"bytes,2,rep,name=match,proto3" json:"match,omitempty"`
+	// set on the last result of a search
+	Done bool `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	// the search stopped at max_results
+	Truncated     bool   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	FilesSearched uint64 `protobuf:"varint,6,opt,name=files_searched,json=filesSearched,proto3" json:"files_searched,omitempty"`
+}
+
+func (x *FileSearchResult) Reset() {
+	*x = FileSearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileSearchResult) ProtoMessage() {}
+
+func (x *FileSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileSearchResult.ProtoReflect.Descriptor instead.
+func (*FileSearchResult) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FileSearchResult) GetSearchId() uint32 {
+	if x != nil {
+		return x.SearchId
+	}
+	return 0
+}
+
+func (x *FileSearchResult) GetMatch() []*FileMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *FileSearchResult) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *FileSearchResult) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *FileSearchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FileSearchResult) GetFilesSearched() uint64 {
+	if x != nil {
+		return x.FilesSearched
+	}
+	return 0
+}
+
+type GitStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Path      string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GitStatus) Reset() {
+	*x = GitStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitStatus) ProtoMessage() {}
+
+func (x *GitStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitStatus.ProtoReflect.Descriptor instead.
+func (*GitStatus) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GitStatus) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *GitStatus) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GitFileStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// relative to the repository's root
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// set for renames and copies
+	OriginalPath string `protobuf:"bytes,2,opt,name=original_path,json=originalPath,proto3" json:"original_path,omitempty"`
+	// as in `git status --porcelain`, e.g. "M" or "?"
+	Index       string `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+	WorkingTree string `protobuf:"bytes,4,opt,name=working_tree,json=workingTree,proto3" json:"working_tree,omitempty"`
+}
+
+func (x *GitFileStatus) Reset() {
+	*x = GitFileStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitFileStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitFileStatus) ProtoMessage() {}
+
+func (x *GitFileStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitFileStatus.ProtoReflect.Descriptor instead.
+func (*GitFileStatus) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GitFileStatus) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GitFileStatus) GetOriginalPath() string {
+	if x != nil {
+		return x.OriginalPath
+	}
+	return ""
+}
+
+func (x *GitFileStatus) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *GitFileStatus) GetWorkingTree() string {
+	if x != nil {
+		return x.WorkingTree
+	}
+	return ""
+}
+
+type GitStatusResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32           `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Root      string           `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Branch    string           `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	Head      string           `protobuf:"bytes,4,opt,name=head,proto3" json:"head,omitempty"`
+	File      []*GitFileStatus `protobuf:"bytes,5,rep,name=file,proto3" json:"file,omitempty"`
+	Error     string           `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GitStatusResult) Reset() {
+	*x = GitStatusResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitStatusResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitStatusResult) ProtoMessage() {}
+
+func (x *GitStatusResult) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitStatusResult.ProtoReflect.Descriptor instead.
+func (*GitStatusResult) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GitStatusResult) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *GitStatusResult) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *GitStatusResult) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *GitStatusResult) GetHead() string {
+	if x != nil {
+		return x.Head
+	}
+	return ""
+}
+
+func (x *GitStatusResult) GetFile() []*GitFileStatus {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *GitStatusResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GitDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// limits the diff to a file or directory
+	Path string       `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Base GitDiff_Base `protobuf:"varint,3,opt,name=base,proto3,enum=protocol.GitDiff_Base" json:"base,omitempty"`
+	// the pull request's target branch, detected from the CI environment if it isn't set
+	Target string `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	// defaults to 3
+	ContextLines uint32 `protobuf:"varint,5,opt,name=context_lines,json=contextLines,proto3" json:"context_lines,omitempty"`
+}
+
+func (x *GitDiff) Reset() {
+	*x = GitDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitDiff) ProtoMessage() {}
+
+func (x *GitDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitDiff.ProtoReflect.Descriptor instead.
+func (*GitDiff) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GitDiff) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *GitDiff) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GitDiff) GetBase() GitDiff_Base {
+	if x != nil {
+		return x.Base
+	}
+	return GitDiff_HEAD
+}
+
+func (x *GitDiff) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *GitDiff) GetContextLines() uint32 {
+	if x != nil {
+		return x.ContextLines
+	}
+	return 0
+}
+
+type GitDiffResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// a unified diff
+	Diff string `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	// the commit the diff is against
+	BaseCommit string `protobuf:"bytes,3,opt,name=base_commit,json=baseCommit,proto3" json:"base_commit,omitempty"`
+	// the diff was cut off at the size limit for file reads
+	Truncated bool   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	Error     string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GitDiffResult) Reset() {
+	*x = GitDiffResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitDiffResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitDiffResult) ProtoMessage() {}
+
+func (x *GitDiffResult) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitDiffResult.ProtoReflect.Descriptor instead.
+func (*GitDiffResult) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GitDiffResult) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *GitDiffResult) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *GitDiffResult) GetBaseCommit() string {
+	if x != nil {
+		return x.BaseCommit
+	}
+	return ""
+}
+
+func (x *GitDiffResult) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *GitDiffResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GitBlame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Path      string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// a range of lines counting from 1, the whole file by default
+	StartLine uint32 `protobuf:"varint,3,opt,name=start_line,json=startLine,proto3" json:"start_line,omitempty"`
+	EndLine   uint32 `protobuf:"varint,4,opt,name=end_line,json=endLine,proto3" json:"end_line,omitempty"`
+}
+
+func (x *GitBlame) Reset() {
+	*x = GitBlame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitBlame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitBlame) ProtoMessage() {}
+
+func (x *GitBlame) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitBlame.ProtoReflect.Descriptor instead.
+func (*GitBlame) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GitBlame) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *GitBlame) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GitBlame) GetStartLine() uint32 {
+	if x != nil {
+		return x.StartLine
+	}
+	return 0
+}
+
+func (x *GitBlame) GetEndLine() uint32 {
+	if x != nil {
+		return x.EndLine
+	}
+	return 0
+}
+
+type GitBlameLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LineNumber  uint32 `protobuf:"varint,1,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`
+	Commit      string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Author      string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	AuthorEmail string `protobuf:"bytes,4,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	// unix seconds
+	AuthorTime int64  `protobuf:"varint,5,opt,name=author_time,json=authorTime,proto3" json:"author_time,omitempty"`
+	Summary    string `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	Line       string `protobuf:"bytes,7,opt,name=line,proto3" json:"line,omitempty"`
+}
+
+func (x *GitBlameLine) Reset() {
+	*x = GitBlameLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitBlameLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitBlameLine) ProtoMessage() {}
+
+func (x *GitBlameLine) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitBlameLine.ProtoReflect.Descriptor instead.
+func (*GitBlameLine) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GitBlameLine) GetLineNumber() uint32 {
+	if x != nil {
+		return x.LineNumber
+	}
+	return 0
+}
+
+func (x *GitBlameLine) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *GitBlameLine) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *GitBlameLine) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *GitBlameLine) GetAuthorTime() int64 {
+	if x != nil {
+		return x.AuthorTime
+	}
+	return 0
+}
+
+func (x *GitBlameLine) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *GitBlameLine) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+type GitBlameResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32          `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Path      string          `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Line      []*GitBlameLine `protobuf:"bytes,3,rep,name=line,proto3" json:"line,omitempty"`
+	Error     string          `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GitBlameResult) Reset() {
+	*x = GitBlameResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitBlameResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitBlameResult) ProtoMessage() {}
+
+func (x *GitBlameResult) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitBlameResult.ProtoReflect.Descriptor instead.
+func (*GitBlameResult) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GitBlameResult) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *GitBlameResult) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GitBlameResult) GetLine() []*GitBlameLine {
+	if x != nil {
+		return x.Line
+	}
+	return nil
+}
+
+func (x *GitBlameResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Exports the changes made to the checkout since the pipeline's commit, with the
+// untracked files changed during the debug session or edited from the dashboard. This
+// writes objects to the repository, so it needs full access to the file browser.
+// Patches bigger than the size limit for file reads, or containing secrets,
+// fail with an error.
+type ExportPatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32             `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Format    ExportPatch_Format `protobuf:"varint,2,opt,name=format,proto3,enum=protocol.ExportPatch_Format" json:"format,omitempty"`
+	// limits the patch to these paths, the whole repository by default
+	Path []string `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
+	// the commit message of a FORMAT_PATCH
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ExportPatch) Reset() {
+	*x = ExportPatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPatch) ProtoMessage() {}
+
+func (x *ExportPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPatch.ProtoReflect.Descriptor instead.
+func (*ExportPatch) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ExportPatch) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *ExportPatch) GetFormat() ExportPatch_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ExportPatch_DIFF
+}
+
+func (x *ExportPatch) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *ExportPatch) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ExportPatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Patch     []byte `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	// the commit the patch applies to
+	BaseCommit string `protobuf:"bytes,3,opt,name=base_commit,json=baseCommit,proto3" json:"base_commit,omitempty"`
+	// the files the patch changes, with the change (A, M or D) in index
+	File []*GitFileStatus `protobuf:"bytes,4,rep,name=file,proto3" json:"file,omitempty"`
+	// files edited from the dashboard which git doesn't track, so aren't in the patch
+	UntrackedEdit []string `protobuf:"bytes,5,rep,name=untracked_edit,json=untrackedEdit,proto3" json:"untracked_edit,omitempty"`
+	// a name to save the patch as
+	FileName string `protobuf:"bytes,6,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Error    string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ExportPatchResult) Reset() {
+	*x = ExportPatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPatchResult) ProtoMessage() {}
+
+func (x *ExportPatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPatchResult.ProtoReflect.Descriptor instead.
+func (*ExportPatchResult) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ExportPatchResult) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *ExportPatchResult) GetPatch() []byte {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+func (x *ExportPatchResult) GetBaseCommit() string {
+	if x != nil {
+		return x.BaseCommit
+	}
+	return ""
+}
+
+func (x *ExportPatchResult) GetFile() []*GitFileStatus {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *ExportPatchResult) GetUntrackedEdit() []string {
+	if x != nil {
+		return x.UntrackedEdit
+	}
+	return nil
+}
+
+func (x *ExportPatchResult) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportPatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Test command output, captured while the command ran
+type CommandOutputChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// starting at 1
+	Attempt uint32                    `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Stream  CommandOutputChunk_Stream `protobuf:"varint,2,opt,name=stream,proto3,enum=protocol.CommandOutputChunk_Stream" json:"stream,omitempty"`
+	// unix time in milliseconds
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Data      []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CommandOutputChunk) Reset() {
+	*x = CommandOutputChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandOutputChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandOutputChunk) ProtoMessage() {}
+
+func (x *CommandOutputChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandOutputChunk.ProtoReflect.Descriptor instead.
+func (*CommandOutputChunk) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CommandOutputChunk) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *CommandOutputChunk) GetStream() CommandOutputChunk_Stream {
+	if x != nil {
+		return x.Stream
+	}
+	return CommandOutputChunk_STDOUT
+}
+
+func (x *CommandOutputChunk) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *CommandOutputChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CommandOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []*CommandOutputChunk `protobuf:"bytes,1,rep,name=chunk,proto3" json:"chunk,omitempty"`
+	// set if older output was dropped to stay within the buffer size
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *CommandOutput) Reset() {
+	*x = CommandOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandOutput) ProtoMessage() {}
+
+func (x *CommandOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandOutput.ProtoReflect.Descriptor instead.
+func (*CommandOutput) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CommandOutput) GetChunk() []*CommandOutputChunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *CommandOutput) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// Test reports
+type TestFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Suite   string `protobuf:"bytes,2,opt,name=suite,proto3" json:"suite,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Details string `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+	// absolute if the file was found, so it can be opened in the file browser
+	File       string `protobuf:"bytes,5,opt,name=file,proto3" json:"file,omitempty"`
+	Line       uint32 `protobuf:"varint,6,opt,name=line,proto3" json:"line,omitempty"`
+	DurationMs uint64 `protobuf:"varint,7,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+}
+
+func (x *TestFailure) Reset() {
+	*x = TestFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestFailure) ProtoMessage() {}
+
+func (x *TestFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestFailure.ProtoReflect.Descriptor instead.
+func (*TestFailure) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TestFailure) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TestFailure) GetSuite() string {
+	if x != nil {
+		return x.Suite
+	}
+	return ""
+}
+
+func (x *TestFailure) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TestFailure) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *TestFailure) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *TestFailure) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *TestFailure) GetDurationMs() uint64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type TestReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total   uint32         `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Failed  uint32         `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped uint32         `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failure []*TestFailure `protobuf:"bytes,4,rep,name=failure,proto3" json:"failure,omitempty"`
+	// the report files which were parsed
+	ReportPath []string `protobuf:"bytes,5,rep,name=report_path,json=reportPath,proto3" json:"report_path,omitempty"`
+	// report files which could not be parsed
+	Error []string `protobuf:"bytes,6,rep,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TestReport) Reset() {
+	*x = TestReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestReport) ProtoMessage() {}
+
+func (x *TestReport) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestReport.ProtoReflect.Descriptor instead.
+func (*TestReport) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TestReport) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TestReport) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *TestReport) GetSkipped() uint32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *TestReport) GetFailure() []*TestFailure {
+	if x != nil {
+		return x.Failure
+	}
+	return nil
+}
+
+func (x *TestReport) GetReportPath() []string {
+	if x != nil {
+		return x.ReportPath
+	}
+	return nil
+}
+
+func (x *TestReport) GetError() []string {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type TestAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// starting at 1
+	Attempt    uint32 `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	ExitCode   int32  `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	DurationMs uint64 `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// only known if test reports are configured
+	FailedTest []string `protobuf:"bytes,4,rep,name=failed_test,json=failedTest,proto3" json:"failed_test,omitempty"`
+	TimedOut   bool     `protobuf:"varint,5,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+}
+
+func (x *TestAttempt) Reset() {
+	*x = TestAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAttempt) ProtoMessage() {}
+
+func (x *TestAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestAttempt.ProtoReflect.Descriptor instead.
+func (*TestAttempt) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TestAttempt) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *TestAttempt) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *TestAttempt) GetDurationMs() uint64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *TestAttempt) GetFailedTest() []string {
+	if x != nil {
+		return x.FailedTest
+	}
+	return nil
+}
+
+func (x *TestAttempt) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+type TestAttempts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attempt []*TestAttempt `protobuf:"bytes,1,rep,name=attempt,proto3" json:"attempt,omitempty"`
+	// tests which failed in one attempt and passed in a later one
+	FlakyTest []string `protobuf:"bytes,2,rep,name=flaky_test,json=flakyTest,proto3" json:"flaky_test,omitempty"`
+}
+
+func (x *TestAttempts) Reset() {
+	*x = TestAttempts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestAttempts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAttempts) ProtoMessage() {}
+
+func (x *TestAttempts) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestAttempts.ProtoReflect.Descriptor instead.
+func (*TestAttempts) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TestAttempts) GetAttempt() []*TestAttempt {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+func (x *TestAttempts) GetFlakyTest() []string {
+	if x != nil {
+		return x.FlakyTest
+	}
+	return nil
+}
+
+type RerunCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// chosen by the dashboard, identifies the rerun's output and result
+	RerunId uint32 `protobuf:"varint,1,opt,name=rerun_id,json=rerunId,proto3" json:"rerun_id,omitempty"`
+	// defaults to the original test command
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	// added to the command's environment
+	Env map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// run in this open terminal session instead of a new pty
+	InTerminal bool   `protobuf:"varint,4,opt,name=in_terminal,json=inTerminal,proto3" json:"in_terminal,omitempty"`
+	SessionId  uint32 `protobuf:"varint,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *RerunCommand) Reset() {
+	*x = RerunCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RerunCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerunCommand) ProtoMessage() {}
+
+func (x *RerunCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerunCommand.ProtoReflect.Descriptor instead.
+func (*RerunCommand) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RerunCommand) GetRerunId() uint32 {
+	if x != nil {
+		return x.RerunId
+	}
+	return 0
+}
+
+func (x *RerunCommand) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *RerunCommand) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *RerunCommand) GetInTerminal() bool {
+	if x != nil {
+		return x.InTerminal
+	}
+	return false
+}
+
+func (x *RerunCommand) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+// output of a rerun in a new pty, reruns in a terminal show up as its output
+type RerunOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RerunId uint32 `protobuf:"varint,1,opt,name=rerun_id,json=rerunId,proto3" json:"rerun_id,omitempty"`
+	Data    []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *RerunOutput) Reset() {
+	*x = RerunOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RerunOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerunOutput) ProtoMessage() {}
+
+func (x *RerunOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerunOutput.ProtoReflect.Descriptor instead.
+func (*RerunOutput) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RerunOutput) GetRerunId() uint32 {
+	if x != nil {
+		return x.RerunId
+	}
+	return 0
+}
+
+func (x *RerunOutput) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RerunResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RerunId    uint32 `protobuf:"varint,1,opt,name=rerun_id,json=rerunId,proto3" json:"rerun_id,omitempty"`
+	ExitCode   int32  `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	DurationMs uint64 `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	TimedOut   bool   `protobuf:"varint,4,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	// set if the command couldn't be run
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RerunResult) Reset() {
+	*x = RerunResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RerunResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerunResult) ProtoMessage() {}
+
+func (x *RerunResult) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerunResult.ProtoReflect.Descriptor instead.
+func (*RerunResult) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RerunResult) GetRerunId() uint32 {
+	if x != nil {
+		return x.RerunId
+	}
+	return 0
+}
+
+func (x *RerunResult) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *RerunResult) GetDurationMs() uint64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *RerunResult) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *RerunResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Initial message
+type Service struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *Service) Reset() {
+	*x = Service{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Service) ProtoMessage() {}
+
+func (x *Service) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Service.ProtoReflect.Descriptor instead.
+func (*Service) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Service) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type Hello struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitHash        string     `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	BranchName        string     `protobuf:"bytes,2,opt,name=branch_name,json=branchName,proto3" json:"branch_name,omitempty"`
+	PullRequest       string     `protobuf:"bytes,3,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	Slug              string     `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	Tag               string     `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	BuildUrl          string     `protobuf:"bytes,6,opt,name=build_url,json=buildUrl,proto3" json:"build_url,omitempty"`
+	BuildId           string     `protobuf:"bytes,7,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	JobId             string     `protobuf:"bytes,8,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	AuthorName        string     `protobuf:"bytes,9,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	AuthorAvatar      string     `protobuf:"bytes,10,opt,name=author_avatar,json=authorAvatar,proto3" json:"author_avatar,omitempty"`
+	AuthorEmail       string     `protobuf:"bytes,11,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	CiProvider        string     `protobuf:"bytes,12,opt,name=ci_provider,json=ciProvider,proto3" json:"ci_provider,omitempty"`
+	AuthorEmailDomain string     `protobuf:"bytes,13,opt,name=author_email_domain,json=authorEmailDomain,proto3" json:"author_email_domain,omitempty"`
+	WorkingDirectory  string     `protobuf:"bytes,14,opt,name=working_directory,json=workingDirectory,proto3" json:"working_directory,omitempty"`
+	Service           []*Service `protobuf:"bytes,15,rep,name=service,proto3" json:"service,omitempty"`
+	// set when resuming a session after a dropped connection
+	SessionId string `protobuf:"bytes,16,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// what dashboard users are allowed to do
+	Access *Access `protobuf:"bytes,17,opt,name=access,proto3" json:"access,omitempty"`
+	// presented along with session_id, from the HelloResponse that started the session
+	ResumeToken string `protobuf:"bytes,18,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *Hello) Reset() {
+	*x = Hello{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hello) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hello) ProtoMessage() {}
+
+func (x *Hello) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hello.ProtoReflect.Descriptor instead.
+func (*Hello) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Hello) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *Hello) GetBranchName() string {
+	if x != nil {
+		return x.BranchName
+	}
+	return ""
+}
+
+func (x *Hello) GetPullRequest() string {
+	if x != nil {
+		return x.PullRequest
+	}
+	return ""
+}
+
+func (x *Hello) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Hello) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *Hello) GetBuildUrl() string {
+	if x != nil {
+		return x.BuildUrl
+	}
+	return ""
+}
+
+func (x *Hello) GetBuildId() string {
+	if x != nil {
+		return x.BuildId
+	}
+	return ""
+}
+
+func (x *Hello) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *Hello) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Hello) GetAuthorAvatar() string {
+	if x != nil {
+		return x.AuthorAvatar
+	}
+	return ""
+}
+
+func (x *Hello) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *Hello) GetCiProvider() string {
+	if x != nil {
+		return x.CiProvider
+	}
+	return ""
+}
+
+func (x *Hello) GetAuthorEmailDomain() string {
+	if x != nil {
+		return x.AuthorEmailDomain
+	}
+	return ""
+}
+
+func (x *Hello) GetWorkingDirectory() string {
+	if x != nil {
+		return x.WorkingDirectory
+	}
+	return ""
+}
+
+func (x *Hello) GetService() []*Service {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *Hello) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *Hello) GetAccess() *Access {
+	if x != nil {
+		return x.Access
+	}
+	return nil
+}
+
+func (x *Hello) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// Access levels for each feature, set in the wrap client's settings
+type Access struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Terminal Access_Level `protobuf:"varint,1,opt,name=terminal,proto3,enum=protocol.Access_Level" json:"terminal,omitempty"`
+	Files    Access_Level `protobuf:"varint,2,opt,name=files,proto3,enum=protocol.Access_Level" json:"files,omitempty"`
+	// tunnels can't be read-only, READ_ONLY disables them
+	Tunnel Access_Level `protobuf:"varint,3,opt,name=tunnel,proto3,enum=protocol.Access_Level" json:"tunnel,omitempty"`
+}
+
+func (x *Access) Reset() {
+	*x = Access{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Access) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Access) ProtoMessage() {}
+
+func (x *Access) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Access.ProtoReflect.Descriptor instead.
+func (*Access) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Access) GetTerminal() Access_Level {
+	if x != nil {
+		return x.Terminal
+	}
+	return Access_FULL
+}
+
+func (x *Access) GetFiles() Access_Level {
+	if x != nil {
+		return x.Files
+	}
+	return Access_FULL
+}
+
+func (x *Access) GetTunnel() Access_Level {
+	if x != nil {
+		return x.Tunnel
+	}
+	return Access_FULL
+}
+
+type HelloResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DashboardUrl string `protobuf:"bytes,1,opt,name=dashboard_url,json=dashboardUrl,proto3" json:"dashboard_url,omitempty"`
+	// presented in Hello when reconnecting
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// a secret which proves a reconnecting client started the session, unlike the session id it's not in the dashboard URL
+	ResumeToken string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *HelloResponse) Reset() {
+	*x = HelloResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HelloResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HelloResponse) ProtoMessage() {}
+
+func (x *HelloResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HelloResponse.ProtoReflect.Descriptor instead.
+func (*HelloResponse) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *HelloResponse) GetDashboardUrl() string {
+	if x != nil {
+		return x.DashboardUrl
+	}
+	return ""
+}
+
+func (x *HelloResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *HelloResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type MessageFromWrapClient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Spec:
+	//	*MessageFromWrapClient_Error
+	//	*MessageFromWrapClient_TcpWriteResult
+	//	*MessageFromWrapClient_TcpReadResult
+	//	*MessageFromWrapClient_TcpDialResult
+	//	*MessageFromWrapClient_TerminalData
+	//	*MessageFromWrapClient_Hello
+	//	*MessageFromWrapClient_FileReadResult
+	//	*MessageFromWrapClient_FileReadDirResult
+	//	*MessageFromWrapClient_CommandOutput
+	//	*MessageFromWrapClient_TestReport
+	//	*MessageFromWrapClient_TestAttempts
+	//	*MessageFromWrapClient_TerminalClose
+	//	*MessageFromWrapClient_TerminalRecording
+	//	*MessageFromWrapClient_RerunOutput
+	//	*MessageFromWrapClient_RerunResult
+	//	*MessageFromWrapClient_FileEditResult
+	//	*MessageFromWrapClient_FileChunk
+	//	*MessageFromWrapClient_DirArchiveProgress
+	//	*MessageFromWrapClient_FileUploadResult
+	//	*MessageFromWrapClient_FileSearchResult
+	//	*MessageFromWrapClient_GitStatusResult
+	//	*MessageFromWrapClient_GitDiffResult
+	//	*MessageFromWrapClient_GitBlameResult
+	//	*MessageFromWrapClient_ExportPatchResult
+	Spec       isMessageFromWrapClient_Spec `protobuf_oneof:"spec"`
+	ListenerId uint32                       `protobuf:"varint,10,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+}
+
+func (x *MessageFromWrapClient) Reset() {
+	*x = MessageFromWrapClient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageFromWrapClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageFromWrapClient) ProtoMessage() {}
+
+func (x *MessageFromWrapClient) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageFromWrapClient.ProtoReflect.Descriptor instead.
+func (*MessageFromWrapClient) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{63}
+}
+
+func (m *MessageFromWrapClient) GetSpec() isMessageFromWrapClient_Spec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetError() string {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_Error); ok {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MessageFromWrapClient) GetTcpWriteResult() *TcpWriteResultMessage {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_TcpWriteResult); ok {
+		return x.TcpWriteResult
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetTcpReadResult() *TcpReadResultMessage {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_TcpReadResult); ok {
+		return x.TcpReadResult
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetTcpDialResult() *TcpDialResultMessage {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_TcpDialResult); ok {
+		return x.TcpDialResult
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetTerminalData() *TerminalData {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_TerminalData); ok {
+		return x.TerminalData
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetHello() *Hello {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_Hello); ok {
+		return x.Hello
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetFileReadResult() *FileReadResult {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_FileReadResult); ok {
+		return x.FileReadResult
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetFileReadDirResult() *FileReadDirResult {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_FileReadDirResult); ok {
+		return x.FileReadDirResult
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetCommandOutput() *CommandOutput {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_CommandOutput); ok {
+		return x.CommandOutput
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetTestReport() *TestReport {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_TestReport); ok {
+		return x.TestReport
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetTestAttempts() *TestAttempts {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_TestAttempts); ok {
+		return x.TestAttempts
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetTerminalClose() *TerminalClose {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_TerminalClose); ok {
+		return x.TerminalClose
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetTerminalRecording() *TerminalRecording {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_TerminalRecording); ok {
+		return x.TerminalRecording
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetRerunOutput() *RerunOutput {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_RerunOutput); ok {
+		return x.RerunOutput
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetRerunResult() *RerunResult {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_RerunResult); ok {
+		return x.RerunResult
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetFileEditResult() *FileEditResult {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_FileEditResult); ok {
+		return x.FileEditResult
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetFileChunk() *FileChunk {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_FileChunk); ok {
+		return x.FileChunk
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetDirArchiveProgress() *DirArchiveProgress {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_DirArchiveProgress); ok {
+		return x.DirArchiveProgress
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetFileUploadResult() *FileUploadResult {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_FileUploadResult); ok {
+		return x.FileUploadResult
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetFileSearchResult() *FileSearchResult {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_FileSearchResult); ok {
+		return x.FileSearchResult
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetGitStatusResult() *GitStatusResult {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_GitStatusResult); ok {
+		return x.GitStatusResult
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetGitDiffResult() *GitDiffResult {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_GitDiffResult); ok {
+		return x.GitDiffResult
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetGitBlameResult() *GitBlameResult {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_GitBlameResult); ok {
+		return x.GitBlameResult
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetExportPatchResult() *ExportPatchResult {
+	if x, ok := x.GetSpec().(*MessageFromWrapClient_ExportPatchResult); ok {
+		return x.ExportPatchResult
+	}
+	return nil
+}
+
+func (x *MessageFromWrapClient) GetListenerId() uint32 {
+	if x != nil {
+		return x.ListenerId
+	}
+	return 0
+}
+
+type isMessageFromWrapClient_Spec interface {
+	isMessageFromWrapClient_Spec()
+}
+
+type MessageFromWrapClient_Error struct {
+	Error string `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type MessageFromWrapClient_TcpWriteResult struct {
+	//TCP
+	TcpWriteResult *TcpWriteResultMessage `protobuf:"bytes,2,opt,name=tcp_write_result,json=tcpWriteResult,proto3,oneof"`
+}
+
+type MessageFromWrapClient_TcpReadResult struct {
+	TcpReadResult *TcpReadResultMessage `protobuf:"bytes,3,opt,name=tcp_read_result,json=tcpReadResult,proto3,oneof"`
+}
+
+type MessageFromWrapClient_TcpDialResult struct {
+	TcpDialResult *TcpDialResultMessage `protobuf:"bytes,4,opt,name=tcp_dial_result,json=tcpDialResult,proto3,oneof"`
+}
+
+type MessageFromWrapClient_TerminalData struct {
+	// Terminal Pty
+	TerminalData *TerminalData `protobuf:"bytes,5,opt,name=terminal_data,json=terminalData,proto3,oneof"`
+}
+
+type MessageFromWrapClient_Hello struct {
+	// metadata
+	Hello *Hello `protobuf:"bytes,6,opt,name=hello,proto3,oneof"`
+}
+
+type MessageFromWrapClient_FileReadResult struct {
+	// File browser
+	FileReadResult *FileReadResult `protobuf:"bytes,7,opt,name=file_read_result,json=fileReadResult,proto3,oneof"`
+}
+
+type MessageFromWrapClient_FileReadDirResult struct {
+	FileReadDirResult *FileReadDirResult `protobuf:"bytes,8,opt,name=file_read_dir_result,json=fileReadDirResult,proto3,oneof"`
+}
+
+type MessageFromWrapClient_CommandOutput struct {
+	// Test command
+	CommandOutput *CommandOutput `protobuf:"bytes,9,opt,name=command_output,json=commandOutput,proto3,oneof"`
+}
+
+type MessageFromWrapClient_TestReport struct {
+	TestReport *TestReport `protobuf:"bytes,11,opt,name=test_report,json=testReport,proto3,oneof"`
+}
+
+type MessageFromWrapClient_TestAttempts struct {
+	TestAttempts *TestAttempts `protobuf:"bytes,12,opt,name=test_attempts,json=testAttempts,proto3,oneof"`
+}
+
+type MessageFromWrapClient_TerminalClose struct {
+	// Terminal sessions
+	TerminalClose *TerminalClose `protobuf:"bytes,13,opt,name=terminal_close,json=terminalClose,proto3,oneof"`
+}
+
+type MessageFromWrapClient_TerminalRecording struct {
+	TerminalRecording *TerminalRecording `protobuf:"bytes,14,opt,name=terminal_recording,json=terminalRecording,proto3,oneof"`
+}
+
+type MessageFromWrapClient_RerunOutput struct {
+	// Reruns
+	RerunOutput *RerunOutput `protobuf:"bytes,15,opt,name=rerun_output,json=rerunOutput,proto3,oneof"`
+}
+
+type MessageFromWrapClient_RerunResult struct {
+	RerunResult *RerunResult `protobuf:"bytes,16,opt,name=rerun_result,json=rerunResult,proto3,oneof"`
+}
+
+type MessageFromWrapClient_FileEditResult struct {
+	// File editing
+	FileEditResult *FileEditResult `protobuf:"bytes,17,opt,name=file_edit_result,json=fileEditResult,proto3,oneof"`
+}
+
+type MessageFromWrapClient_FileChunk struct {
+	// File downloads
+	FileChunk *FileChunk `protobuf:"bytes,18,opt,name=file_chunk,json=fileChunk,proto3,oneof"`
+}
+
+type MessageFromWrapClient_DirArchiveProgress struct {
+	DirArchiveProgress *DirArchiveProgress `protobuf:"bytes,19,opt,name=dir_archive_progress,json=dirArchiveProgress,proto3,oneof"`
+}
+
+type MessageFromWrapClient_FileUploadResult struct {
+	// File uploads
+	FileUploadResult *FileUploadResult `protobuf:"bytes,20,opt,name=file_upload_result,json=fileUploadResult,proto3,oneof"`
+}
+
+type MessageFromWrapClient_FileSearchResult struct {
+	// File search
+	FileSearchResult *FileSearchResult `protobuf:"bytes,21,opt,name=file_search_result,json=fileSearchResult,proto3,oneof"`
+}
+
+type MessageFromWrapClient_GitStatusResult struct {
+	// Git
+	GitStatusResult *GitStatusResult `protobuf:"bytes,22,opt,name=git_status_result,json=gitStatusResult,proto3,oneof"`
+}
+
+type MessageFromWrapClient_GitDiffResult struct {
+	GitDiffResult *GitDiffResult `protobuf:"bytes,23,opt,name=git_diff_result,json=gitDiffResult,proto3,oneof"`
+}
+
+type MessageFromWrapClient_GitBlameResult struct {
+	GitBlameResult *GitBlameResult `protobuf:"bytes,24,opt,name=git_blame_result,json=gitBlameResult,proto3,oneof"`
+}
+
+type MessageFromWrapClient_ExportPatchResult struct {
+	ExportPatchResult *ExportPatchResult `protobuf:"bytes,25,opt,name=export_patch_result,json=exportPatchResult,proto3,oneof"`
+}
+
+func (*MessageFromWrapClient_Error) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_TcpWriteResult) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_TcpReadResult) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_TcpDialResult) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_TerminalData) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_Hello) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_FileReadResult) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_FileReadDirResult) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_CommandOutput) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_TestReport) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_TestAttempts) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_TerminalClose) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_TerminalRecording) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_RerunOutput) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_RerunResult) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_FileEditResult) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_FileChunk) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_DirArchiveProgress) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_FileUploadResult) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_FileSearchResult) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_GitStatusResult) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_GitDiffResult) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_GitBlameResult) isMessageFromWrapClient_Spec() {}
+
+func (*MessageFromWrapClient_ExportPatchResult) isMessageFromWrapClient_Spec() {}
+
+type MessageToWrapClient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Spec:
+	//	*MessageToWrapClient_Error
+	//	*MessageToWrapClient_TcpWriteCall
+	//	*MessageToWrapClient_TcpReadCall
+	//	*MessageToWrapClient_TcpDialCall
+	//	*MessageToWrapClient_TerminalWrite
+	//	*MessageToWrapClient_TerminalWidth
+	//	*MessageToWrapClient_FileRead
+	//	*MessageToWrapClient_FileReadDir
+	//	*MessageToWrapClient_HelloResponse
+	//	*MessageToWrapClient_Close
+	//	*MessageToWrapClient_TerminalOpen
+	//	*MessageToWrapClient_TerminalClose
+	//	*MessageToWrapClient_TerminalResize
+	//	*MessageToWrapClient_RerunCommand
+	//	*MessageToWrapClient_FileWrite
+	//	*MessageToWrapClient_FileCreate
+	//	*MessageToWrapClient_FileDelete
+	//	*MessageToWrapClient_FileRename
+	//	*MessageToWrapClient_MakeDir
+	//	*MessageToWrapClient_FileDownload
+	//	*MessageToWrapClient_FileChunkAck
+	//	*MessageToWrapClient_DirArchive
+	//	*MessageToWrapClient_FileUploadBegin
+	//	*MessageToWrapClient_FileUploadChunk
+	//	*MessageToWrapClient_FileUploadCommit
+	//	*MessageToWrapClient_FileUploadAbort
+	//	*MessageToWrapClient_FileSearch
+	//	*MessageToWrapClient_FileGrep
+	//	*MessageToWrapClient_FileSearchCancel
+	//	*MessageToWrapClient_GitStatus
+	//	*MessageToWrapClient_GitDiff
+	//	*MessageToWrapClient_GitBlame
+	//	*MessageToWrapClient_ExportPatch
+	Spec       isMessageToWrapClient_Spec `protobuf_oneof:"spec"`
+	ListenerId uint32                     `protobuf:"varint,11,opt,name=listener_id,json=listenerId,proto3" json:"listener_id,omitempty"`
+}
+
+func (x *MessageToWrapClient) Reset() {
+	*x = MessageToWrapClient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_WrapperMessage_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageToWrapClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageToWrapClient) ProtoMessage() {}
+
+func (x *MessageToWrapClient) ProtoReflect() protoreflect.Message {
+	mi := &file_WrapperMessage_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageToWrapClient.ProtoReflect.Descriptor instead.
+func (*MessageToWrapClient) Descriptor() ([]byte, []int) {
+	return file_WrapperMessage_proto_rawDescGZIP(), []int{64}
+}
+
+func (m *MessageToWrapClient) GetSpec() isMessageToWrapClient_Spec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetError() string {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_Error); ok {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MessageToWrapClient) GetTcpWriteCall() *TcpWriteMessage {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_TcpWriteCall); ok {
+		return x.TcpWriteCall
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetTcpReadCall() *TcpReadMessage {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_TcpReadCall); ok {
+		return x.TcpReadCall
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetTcpDialCall() *TcpDialMessage {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_TcpDialCall); ok {
+		return x.TcpDialCall
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetTerminalWrite() *TerminalData {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_TerminalWrite); ok {
+		return x.TerminalWrite
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetTerminalWidth() *TerminalWidth {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_TerminalWidth); ok {
+		return x.TerminalWidth
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileRead() *FileRead {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileRead); ok {
+		return x.FileRead
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileReadDir() *FileReadDir {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileReadDir); ok {
+		return x.FileReadDir
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetHelloResponse() *HelloResponse {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_HelloResponse); ok {
+		return x.HelloResponse
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetClose() bool {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_Close); ok {
+		return x.Close
+	}
+	return false
+}
+
+func (x *MessageToWrapClient) GetTerminalOpen() *TerminalOpen {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_TerminalOpen); ok {
+		return x.TerminalOpen
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetTerminalClose() *TerminalClose {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_TerminalClose); ok {
+		return x.TerminalClose
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetTerminalResize() *TerminalResize {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_TerminalResize); ok {
+		return x.TerminalResize
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetRerunCommand() *RerunCommand {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_RerunCommand); ok {
+		return x.RerunCommand
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileWrite() *FileWrite {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileWrite); ok {
+		return x.FileWrite
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileCreate() *FileCreate {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileCreate); ok {
+		return x.FileCreate
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileDelete() *FileDelete {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileDelete); ok {
+		return x.FileDelete
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileRename() *FileRename {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileRename); ok {
+		return x.FileRename
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetMakeDir() *MakeDir {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_MakeDir); ok {
+		return x.MakeDir
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileDownload() *FileDownload {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileDownload); ok {
+		return x.FileDownload
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileChunkAck() *FileChunkAck {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileChunkAck); ok {
+		return x.FileChunkAck
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetDirArchive() *DirArchive {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_DirArchive); ok {
+		return x.DirArchive
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileUploadBegin() *FileUploadBegin {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileUploadBegin); ok {
+		return x.FileUploadBegin
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileUploadChunk() *FileUploadChunk {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileUploadChunk); ok {
+		return x.FileUploadChunk
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileUploadCommit() *FileUploadCommit {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileUploadCommit); ok {
+		return x.FileUploadCommit
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileUploadAbort() *FileUploadAbort {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileUploadAbort); ok {
+		return x.FileUploadAbort
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileSearch() *FileSearch {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileSearch); ok {
+		return x.FileSearch
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileGrep() *FileGrep {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileGrep); ok {
+		return x.FileGrep
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetFileSearchCancel() *FileSearchCancel {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_FileSearchCancel); ok {
+		return x.FileSearchCancel
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetGitStatus() *GitStatus {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_GitStatus); ok {
+		return x.GitStatus
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetGitDiff() *GitDiff {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_GitDiff); ok {
+		return x.GitDiff
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetGitBlame() *GitBlame {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_GitBlame); ok {
+		return x.GitBlame
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetExportPatch() *ExportPatch {
+	if x, ok := x.GetSpec().(*MessageToWrapClient_ExportPatch); ok {
+		return x.ExportPatch
+	}
+	return nil
+}
+
+func (x *MessageToWrapClient) GetListenerId() uint32 {
+	if x != nil {
+		return x.ListenerId
+	}
+	return 0
+}
+
+type isMessageToWrapClient_Spec interface {
+	isMessageToWrapClient_Spec()
+}
+
+type MessageToWrapClient_Error struct {
+	Error string `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type MessageToWrapClient_TcpWriteCall struct {
+	// TCP
+	TcpWriteCall *TcpWriteMessage `protobuf:"bytes,2,opt,name=tcp_write_call,json=tcpWriteCall,proto3,oneof"`
+}
+
+type MessageToWrapClient_TcpReadCall struct {
+	TcpReadCall *TcpReadMessage `protobuf:"bytes,3,opt,name=tcp_read_call,json=tcpReadCall,proto3,oneof"`
+}
+
+type MessageToWrapClient_TcpDialCall struct {
+	TcpDialCall *TcpDialMessage `protobuf:"bytes,4,opt,name=tcp_dial_call,json=tcpDialCall,proto3,oneof"`
+}
+
+type MessageToWrapClient_TerminalWrite struct {
+	// Terminal Pty
+	TerminalWrite *TerminalData `protobuf:"bytes,5,opt,name=terminal_write,json=terminalWrite,proto3,oneof"`
+}
+
+type MessageToWrapClient_TerminalWidth struct {
+	TerminalWidth *TerminalWidth `protobuf:"bytes,6,opt,name=terminal_width,json=terminalWidth,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileRead struct {
+	// File browser
+	FileRead *FileRead `protobuf:"bytes,7,opt,name=file_read,json=fileRead,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileReadDir struct {
+	FileReadDir *FileReadDir `protobuf:"bytes,8,opt,name=file_read_dir,json=fileReadDir,proto3,oneof"`
+}
+
+type MessageToWrapClient_HelloResponse struct {
+	// metadata
+	HelloResponse *HelloResponse `protobuf:"bytes,9,opt,name=hello_response,json=helloResponse,proto3,oneof"`
+}
+
+type MessageToWrapClient_Close struct {
+	// signal to shut down immediately
+	Close bool `protobuf:"varint,10,opt,name=close,proto3,oneof"`
+}
+
+type MessageToWrapClient_TerminalOpen struct {
+	// Terminal sessions
+	TerminalOpen *TerminalOpen `protobuf:"bytes,12,opt,name=terminal_open,json=terminalOpen,proto3,oneof"`
+}
+
+type MessageToWrapClient_TerminalClose struct {
+	TerminalClose *TerminalClose `protobuf:"bytes,13,opt,name=terminal_close,json=terminalClose,proto3,oneof"`
+}
+
+type MessageToWrapClient_TerminalResize struct {
+	TerminalResize *TerminalResize `protobuf:"bytes,14,opt,name=terminal_resize,json=terminalResize,proto3,oneof"`
+}
+
+type MessageToWrapClient_RerunCommand struct {
+	// Reruns
+	RerunCommand *RerunCommand `protobuf:"bytes,15,opt,name=rerun_command,json=rerunCommand,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileWrite struct {
+	// File editing
+	FileWrite *FileWrite `protobuf:"bytes,16,opt,name=file_write,json=fileWrite,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileCreate struct {
+	FileCreate *FileCreate `protobuf:"bytes,17,opt,name=file_create,json=fileCreate,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileDelete struct {
+	FileDelete *FileDelete `protobuf:"bytes,18,opt,name=file_delete,json=fileDelete,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileRename struct {
+	FileRename *FileRename `protobuf:"bytes,19,opt,name=file_rename,json=fileRename,proto3,oneof"`
+}
+
+type MessageToWrapClient_MakeDir struct {
+	MakeDir *MakeDir `protobuf:"bytes,20,opt,name=make_dir,json=makeDir,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileDownload struct {
+	// File downloads
+	FileDownload *FileDownload `protobuf:"bytes,21,opt,name=file_download,json=fileDownload,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileChunkAck struct {
+	FileChunkAck *FileChunkAck `protobuf:"bytes,22,opt,name=file_chunk_ack,json=fileChunkAck,proto3,oneof"`
+}
+
+type MessageToWrapClient_DirArchive struct {
+	DirArchive *DirArchive `protobuf:"bytes,23,opt,name=dir_archive,json=dirArchive,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileUploadBegin struct {
+	// File uploads
+	FileUploadBegin *FileUploadBegin `protobuf:"bytes,24,opt,name=file_upload_begin,json=fileUploadBegin,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileUploadChunk struct {
+	FileUploadChunk *FileUploadChunk `protobuf:"bytes,25,opt,name=file_upload_chunk,json=fileUploadChunk,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileUploadCommit struct {
+	FileUploadCommit *FileUploadCommit `protobuf:"bytes,26,opt,name=file_upload_commit,json=fileUploadCommit,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileUploadAbort struct {
+	FileUploadAbort *FileUploadAbort `protobuf:"bytes,27,opt,name=file_upload_abort,json=fileUploadAbort,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileSearch struct {
+	// File search
+	FileSearch *FileSearch `protobuf:"bytes,28,opt,name=file_search,json=fileSearch,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileGrep struct {
+	FileGrep *FileGrep `protobuf:"bytes,29,opt,name=file_grep,json=fileGrep,proto3,oneof"`
+}
+
+type MessageToWrapClient_FileSearchCancel struct {
+	FileSearchCancel *FileSearchCancel `protobuf:"bytes,30,opt,name=file_search_cancel,json=fileSearchCancel,proto3,oneof"`
+}
+
+type MessageToWrapClient_GitStatus struct {
+	// Git
+	GitStatus *GitStatus `protobuf:"bytes,31,opt,name=git_status,json=gitStatus,proto3,oneof"`
+}
+
+type MessageToWrapClient_GitDiff struct {
+	GitDiff *GitDiff `protobuf:"bytes,32,opt,name=git_diff,json=gitDiff,proto3,oneof"`
+}
+
+type MessageToWrapClient_GitBlame struct {
+	GitBlame *GitBlame `protobuf:"bytes,33,opt,name=git_blame,json=gitBlame,proto3,oneof"`
+}
+
+type MessageToWrapClient_ExportPatch struct {
+	ExportPatch *ExportPatch `protobuf:"bytes,34,opt,name=export_patch,json=exportPatch,proto3,oneof"`
+}
+
+func (*MessageToWrapClient_Error) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_TcpWriteCall) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_TcpReadCall) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_TcpDialCall) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_TerminalWrite) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_TerminalWidth) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileRead) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileReadDir) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_HelloResponse) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_Close) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_TerminalOpen) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_TerminalClose) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_TerminalResize) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_RerunCommand) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileWrite) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileCreate) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileDelete) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileRename) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_MakeDir) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileDownload) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileChunkAck) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_DirArchive) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileUploadBegin) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileUploadChunk) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileUploadCommit) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileUploadAbort) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileSearch) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileGrep) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_FileSearchCancel) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_GitStatus) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_GitDiff) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_GitBlame) isMessageToWrapClient_Spec() {}
+
+func (*MessageToWrapClient_ExportPatch) isMessageToWrapClient_Spec() {}
+
+var File_WrapperMessage_proto protoreflect.FileDescriptor
+
+var file_WrapperMessage_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x22, 0x4f, 0x0a, 0x0e, 0x54, 0x63, 0x70, 0x44, 0x69, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x6b, 0x0a, 0x14, 0x54, 0x63, 0x70, 0x44, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x67,
+	0x0a, 0x0f, 0x54, 0x63, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x94, 0x01, 0x0a, 0x15, 0x54, 0x63, 0x70, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x73,
+	0x0a, 0x0e, 0x54, 0x63, 0x70, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x14, 0x54, 0x63, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x41, 0x0a, 0x0c, 0x54, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x0d, 0x54, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6e,
+	0x65, 0x77, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x6e, 0x65, 0x77, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x0e, 0x54, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x6c,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x2d, 0x0a, 0x0c, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4f, 0x70, 0x65, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0x5a, 0x0a, 0x11, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2e, 0x0a, 0x0d, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x08, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66,
+	0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x61, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x64, 0x61, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0x73, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x22, 0xc8, 0x01, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0xe7, 0x02, 0x0a, 0x0a, 0x44, 0x69, 0x72, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x44,
+	0x69, 0x72, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x79, 0x6d, 0x6c, 0x69,
+	0x6e, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x44, 0x69, 0x72, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2e,
+	0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x08, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e,
+	0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x1d, 0x0a, 0x06, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x0a, 0x0a, 0x06, 0x54, 0x41, 0x52, 0x5f, 0x47, 0x5a, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x5a, 0x49, 0x50, 0x10, 0x01, 0x22, 0x2e, 0x0a, 0x08, 0x53, 0x79, 0x6d,
+	0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56,
+	0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12,
+	0x08, 0x0a, 0x04, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x02, 0x22, 0xb2, 0x01, 0x0a, 0x12, 0x44, 0x69,
+	0x72, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f,
+	0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x22, 0x59,
+	0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x22, 0x21, 0x0a, 0x0b, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xf3, 0x02, 0x0a,
+	0x08, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x69, 0x73, 0x44, 0x69, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x67, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x22, 0x67, 0x0a, 0x11, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69,
+	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x44, 0x69, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xed, 0x01, 0x0a, 0x09,
+	0x46, 0x69, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x67, 0x0a, 0x0a, 0x46,
+	0x69, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x22, 0x5d, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69,
+	0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73,
+	0x69, 0x76, 0x65, 0x22, 0x78, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0x6a, 0x0a,
+	0x07, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xf4, 0x01, 0x0a, 0x09, 0x46, 0x69,
+	0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x9e, 0x01, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
+	0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x45, 0x52, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x04, 0x12, 0x13, 0x0a,
+	0x0f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x53, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x4f,
+	0x52, 0x59, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4d, 0x50, 0x54,
+	0x59, 0x10, 0x07, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x4f, 0x4f, 0x5f, 0x42, 0x49, 0x47, 0x10, 0x08,
+	0x22, 0xa1, 0x01, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x22, 0x88, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22,
+	0x54, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x47, 0x0a, 0x10, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x2e,
+	0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x62, 0x6f, 0x72,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x22, 0xa6,
+	0x01, 0x0a, 0x10, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0xb7, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x64, 0x22, 0xff, 0x01, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x47, 0x72, 0x65, 0x70, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x09, 0x54, 0x65, 0x78, 0x74, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xda, 0x01, 0x0a, 0x09, 0x46, 0x69,
+	0x6c, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x15, 0x0a, 0x06, 0x69,
+	0x73, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x44,
+	0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c,
+	0x69, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x29, 0x0a,
+	0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0xc9, 0x01, 0x0a, 0x10, 0x46, 0x69, 0x6c, 0x65, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x64, 0x22, 0x3e, 0x0a, 0x09, 0x47, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x22, 0x81, 0x01, 0x0a, 0x0d, 0x47, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x72, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x54, 0x72, 0x65, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x0f, 0x47, 0x69, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x61, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x47, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc7, 0x01, 0x0a,
+	0x07, 0x47, 0x69, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x04, 0x62,
+	0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x47, 0x69, 0x74, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x42, 0x61, 0x73,
+	0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4c,
+	0x69, 0x6e, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x04, 0x42, 0x61, 0x73, 0x65, 0x12, 0x08, 0x0a, 0x04,
+	0x48, 0x45, 0x41, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f,
+	0x42, 0x41, 0x53, 0x45, 0x10, 0x01, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x47, 0x69, 0x74, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x77, 0x0a, 0x08, 0x47, 0x69, 0x74, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x0c, 0x47, 0x69,
+	0x74, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x85, 0x01,
+	0x0a, 0x0e, 0x47, 0x69, 0x74, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x47, 0x69, 0x74,
+	0x42, 0x6c, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb6, 0x01, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x24, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x49, 0x46, 0x46, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x41, 0x54, 0x43, 0x48, 0x10, 0x01, 0x22, 0xf0,
+	0x01, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x47, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x5f, 0x65, 0x64, 0x69, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x75, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x45, 0x64, 0x69, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0xc8, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x29, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x54, 0x44, 0x4f, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x44, 0x45, 0x52,
+	0x52, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x54, 0x59, 0x10, 0x02, 0x22, 0x61, 0x0a, 0x0d,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a,
+	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22,
+	0xb4, 0x01, 0x0a, 0x0b, 0x54, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x0a, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x2f, 0x0a,
+	0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa3, 0x01, 0x0a, 0x0b, 0x54, 0x65, 0x73, 0x74, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x22, 0x5e, 0x0a, 0x0c, 0x54,
+	0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x66, 0x6c, 0x61, 0x6b, 0x79, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x66, 0x6c, 0x61, 0x6b, 0x79, 0x54, 0x65, 0x73, 0x74, 0x22, 0xee, 0x01, 0x0a, 0x0c,
+	0x52, 0x65, 0x72, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x65, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x72, 0x65, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x31, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x03, 0x65, 0x6e, 0x76, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x54, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3c, 0x0a, 0x0b,
+	0x52, 0x65, 0x72, 0x75, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x65, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72,
+	0x65, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x99, 0x01, 0x0a, 0x0b, 0x52,
+	0x65, 0x72, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65,
+	0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65,
+	0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x23, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe1, 0x04, 0x0a, 0x05,
+	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x6c, 0x6c, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c,
+	0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a,
+	0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x61, 0x76, 0x61, 0x74, 0x61,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x41,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x69, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x69, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xca, 0x01, 0x0a, 0x06, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x2c,
+	0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x06,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x52, 0x06, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x2e, 0x0a, 0x05,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0x76, 0x0a, 0x0d,
+	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x55,
+	0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x80, 0x0d, 0x0a, 0x15, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x57, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4b, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x70, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x48, 0x0a, 0x0f, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d,
+	0x74, 0x63, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x48, 0x0a,
+	0x0f, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x54, 0x63, 0x70, 0x44, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x63, 0x70, 0x44, 0x69, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x74, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x12,
+	0x44, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4e, 0x0a, 0x14, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x48, 0x00, 0x52, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x3d, 0x0a, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x48,
+	0x00, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x40, 0x0a, 0x0e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x0d, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x12, 0x4c, 0x0a, 0x12, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x11, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x3a, 0x0a, 0x0c, 0x72, 0x65, 0x72, 0x75, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x0b,
+	0x72, 0x65, 0x72, 0x75, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x72,
+	0x65, 0x72, 0x75, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x72,
+	0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x72, 0x75,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x65, 0x64, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x66,
+	0x69, 0x6c, 0x65, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x34, 0x0a,
+	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x12, 0x50, 0x0a, 0x14, 0x64, 0x69, 0x72, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x44, 0x69, 0x72,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48,
+	0x00, 0x52, 0x12, 0x64, 0x69, 0x72, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4a, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52,
+	0x10, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x4a, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x10, 0x66, 0x69, 0x6c,
+	0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x47, 0x0a,
+	0x11, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x67, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x67, 0x69, 0x74, 0x5f, 0x64, 0x69,
+	0x66, 0x66, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x47, 0x69, 0x74, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x67, 0x69, 0x74, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x67, 0x69, 0x74,
+	0x5f, 0x62, 0x6c, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x47,
+	0x69, 0x74, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52,
+	0x0e, 0x67, 0x69, 0x74, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x4d, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x11, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x42,
+	0x06, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0xe8, 0x0f, 0x0a, 0x13, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x57, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x70, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x63,
+	0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x3e, 0x0a, 0x0d, 0x74, 0x63,
+	0x70, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x70,
+	0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x74,
+	0x63, 0x70, 0x52, 0x65, 0x61, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x3e, 0x0a, 0x0d, 0x74, 0x63,
+	0x70, 0x5f, 0x64, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x70,
+	0x44, 0x69, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x74,
+	0x63, 0x70, 0x44, 0x69, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x3f, 0x0a, 0x0e, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x64, 0x74, 0x68, 0x48, 0x00, 0x52, 0x0d,
+	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x31, 0x0a,
+	0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x61, 0x64, 0x48, 0x00, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x12, 0x3b, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x64, 0x69,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x48, 0x00,
+	0x52, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x12, 0x40, 0x0a,
+	0x0e, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x0d, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x74, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x74, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x3d, 0x0a,
+	0x0d, 0x72, 0x65, 0x72, 0x75, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x52, 0x65, 0x72, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0c,
+	0x72, 0x65, 0x72, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x0a,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x48, 0x00, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
+	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x48,
+	0x00, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a,
+	0x08, 0x6d, 0x61, 0x6b, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44,
+	0x69, 0x72, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x12, 0x3d, 0x0a,
+	0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0c,
+	0x66, 0x69, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3e, 0x0a, 0x0e,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x0c,
+	0x66, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x41, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x0b,
+	0x64, 0x69, 0x72, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x44, 0x69, 0x72,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0f, 0x66,
+	0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x47,
+	0x0a, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x4a, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48,
+	0x00, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x66, 0x69, 0x6c,
+	0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x37, 0x0a, 0x0b,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x31, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x67, 0x72,
+	0x65, 0x70, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x47, 0x72, 0x65, 0x70, 0x48, 0x00, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x47, 0x72, 0x65, 0x70, 0x12, 0x4a, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18, 0x1e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x48, 0x00, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x12, 0x34, 0x0a, 0x0a, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52,
+	0x09, 0x67, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x67, 0x69,
+	0x74, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x47, 0x69, 0x74, 0x44, 0x69, 0x66, 0x66, 0x48,
+	0x00, 0x52, 0x07, 0x67, 0x69, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x31, 0x0a, 0x09, 0x67, 0x69,
+	0x74, 0x5f, 0x62, 0x6c, 0x61, 0x6d, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x47, 0x69, 0x74, 0x42, 0x6c, 0x61, 0x6d,
+	0x65, 0x48, 0x00, 0x52, 0x08, 0x67, 0x69, 0x74, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a,
+	0x0c, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x22, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_WrapperMessage_proto_rawDescOnce sync.Once
+	file_WrapperMessage_proto_rawDescData = file_WrapperMessage_proto_rawDesc
+)
+
+func file_WrapperMessage_proto_rawDescGZIP() []byte {
+	file_WrapperMessage_proto_rawDescOnce.Do(func() {
+		file_WrapperMessage_proto_rawDescData = protoimpl.X.CompressGZIP(file_WrapperMessage_proto_rawDescData)
+	})
+	return file_WrapperMessage_proto_rawDescData
+}
+
+var file_WrapperMessage_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_WrapperMessage_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
+var file_WrapperMessage_proto_goTypes = []interface{}{
+	(DirArchive_Format)(0),         // 0: protocol.DirArchive.Format
+	(DirArchive_Symlinks)(0),       // 1: protocol.DirArchive.Symlinks
+	(FileError_Code)(0),            // 2: protocol.FileError.Code
+	(GitDiff_Base)(0),              // 3: protocol.GitDiff.Base
+	(ExportPatch_Format)(0),        // 4: protocol.ExportPatch.Format
+	(CommandOutputChunk_Stream)(0), // 5: protocol.CommandOutputChunk.Stream
+	(Access_Level)(0),              // 6: protocol.Access.Level
+	(*TcpDialMessage)(nil),         // 7: protocol.TcpDialMessage
+	(*TcpDialResultMessage)(nil),   // 8: protocol.TcpDialResultMessage
+	(*TcpWriteMessage)(nil),        // 9: protocol.TcpWriteMessage
+	(*TcpWriteResultMessage)(nil),  // 10: protocol.TcpWriteResultMessage
+	(*TcpReadMessage)(nil),         // 11: protocol.TcpReadMessage
+	(*TcpReadResultMessage)(nil),   // 12: protocol.TcpReadResultMessage
+	(*TerminalData)(nil),           // 13: protocol.TerminalData
+	(*TerminalWidth)(nil),          // 14: protocol.TerminalWidth
+	(*TerminalResize)(nil),         // 15: protocol.TerminalResize
+	(*TerminalOpen)(nil),           // 16: protocol.TerminalOpen
+	(*TerminalRecording)(nil),      // 17: protocol.TerminalRecording
+	(*TerminalClose)(nil),          // 18: protocol.TerminalClose
+	(*FileRead)(nil),               // 19: protocol.FileRead
+	(*FileReadResult)(nil),         // 20: protocol.FileReadResult
+	(*FileDownload)(nil),           // 21: protocol.FileDownload
+	(*FileChunk)(nil),              // 22: protocol.FileChunk
+	(*DirArchive)(nil),             // 23: protocol.DirArchive
+	(*DirArchiveProgress)(nil),     // 24: protocol.DirArchiveProgress
+	(*FileChunkAck)(nil),           // 25: protocol.FileChunkAck
+	(*FileReadDir)(nil),            // 26: protocol.FileReadDir
+	(*DirEntry)(nil),               // 27: protocol.DirEntry
+	(*FileReadDirResult)(nil),      // 28: protocol.FileReadDirResult
+	(*FileWrite)(nil),              // 29: protocol.FileWrite
+	(*FileCreate)(nil),             // 30: protocol.FileCreate
+	(*FileDelete)(nil),             // 31: protocol.FileDelete
+	(*FileRename)(nil),             // 32: protocol.FileRename
+	(*MakeDir)(nil),                // 33: protocol.MakeDir
+	(*FileError)(nil),              // 34: protocol.FileError
+	(*FileEditResult)(nil),         // 35: protocol.FileEditResult
+	(*FileUploadBegin)(nil),        // 36: protocol.FileUploadBegin
+	(*FileUploadChunk)(nil),        // 37: protocol.FileUploadChunk
+	(*FileUploadCommit)(nil),       // 38: protocol.FileUploadCommit
+	(*FileUploadAbort)(nil),        // 39: protocol.FileUploadAbort
+	(*FileUploadResult)(nil),       // 40: protocol.FileUploadResult
+	(*FileSearch)(nil),             // 41: protocol.FileSearch
+	(*FileGrep)(nil),               // 42: protocol.FileGrep
+	(*FileSearchCancel)(nil),       // 43: protocol.FileSearchCancel
+	(*TextRange)(nil),              // 44: protocol.TextRange
+	(*FileMatch)(nil),              // 45: protocol.FileMatch
+	(*FileSearchResult)(nil),       // 46: protocol.FileSearchResult
+	(*GitStatus)(nil),              // 47: protocol.GitStatus
+	(*GitFileStatus)(nil),          // 48: protocol.GitFileStatus
+	(*GitStatusResult)(nil),        // 49: protocol.GitStatusResult
+	(*GitDiff)(nil),                // 50: protocol.GitDiff
+	(*GitDiffResult)(nil),          // 51: protocol.GitDiffResult
+	(*GitBlame)(nil),               // 52: protocol.GitBlame
+	(*GitBlameLine)(nil),           // 53: protocol.GitBlameLine
+	(*GitBlameResult)(nil),         // 54: protocol.GitBlameResult
+	(*ExportPatch)(nil),            // 55: protocol.ExportPatch
+	(*ExportPatchResult)(nil),      // 56: protocol.ExportPatchResult
+	(*CommandOutputChunk)(nil),     // 57: protocol.CommandOutputChunk
+	(*CommandOutput)(nil),          // 58: protocol.CommandOutput
+	(*TestFailure)(nil),            // 59: protocol.TestFailure
+	(*TestReport)(nil),             // 60: protocol.TestReport
+	(*TestAttempt)(nil),            // 61: protocol.TestAttempt
+	(*TestAttempts)(nil),           // 62: protocol.TestAttempts
+	(*RerunCommand)(nil),           // 63: protocol.RerunCommand
+	(*RerunOutput)(nil),            // 64: protocol.RerunOutput
+	(*RerunResult)(nil),            // 65: protocol.RerunResult
+	(*Service)(nil),                // 66: protocol.Service
+	(*Hello)(nil),                  // 67: protocol.Hello
+	(*Access)(nil),                 // 68: protocol.Access
+	(*HelloResponse)(nil),          // 69: protocol.HelloResponse
+	(*MessageFromWrapClient)(nil),  // 70: protocol.MessageFromWrapClient
+	(*MessageToWrapClient)(nil),    // 71: protocol.MessageToWrapClient
+	nil,                            // 72: protocol.RerunCommand.EnvEntry
+}
+var file_WrapperMessage_proto_depIdxs = []int32{
+	0,  // 0: protocol.DirArchive.format:type_name -> protocol.DirArchive.Format
+	1,  // 1: protocol.DirArchive.symlinks:type_name -> protocol.DirArchive.Symlinks
+	27, // 2: protocol.FileReadDirResult.entry:type_name -> protocol.DirEntry
+	2,  // 3: protocol.FileError.code:type_name -> protocol.FileError.Code
+	34, // 4: protocol.FileEditResult.error:type_name -> protocol.FileError
+	34, // 5: protocol.FileUploadResult.error:type_name -> protocol.FileError
+	44, // 6: protocol.FileMatch.range:type_name -> protocol.TextRange
+	45, // 7: protocol.FileSearchResult.match:type_name -> protocol.FileMatch
+	48, // 8: protocol.GitStatusResult.file:type_name -> protocol.GitFileStatus
+	3,  // 9: protocol.GitDiff.base:type_name -> protocol.GitDiff.Base
+	53, // 10: protocol.GitBlameResult.line:type_name -> protocol.GitBlameLine
+	4,  // 11: protocol.ExportPatch.format:type_name -> protocol.ExportPatch.Format
+	48, // 12: protocol.ExportPatchResult.file:type_name -> protocol.GitFileStatus
+	5,  // 13: protocol.CommandOutputChunk.stream:type_name -> protocol.CommandOutputChunk.Stream
+	57, // 14: protocol.CommandOutput.chunk:type_name -> protocol.CommandOutputChunk
+	59, // 15: protocol.TestReport.failure:type_name -> protocol.TestFailure
+	61, // 16: protocol.TestAttempts.attempt:type_name -> protocol.TestAttempt
+	72, // 17: protocol.RerunCommand.env:type_name -> protocol.RerunCommand.EnvEntry
+	66, // 18: protocol.Hello.service:type_name -> protocol.Service
+	68, // 19: protocol.Hello.access:type_name -> protocol.Access
+	6,  // 20: protocol.Access.terminal:type_name -> protocol.Access.Level
+	6,  // 21: protocol.Access.files:type_name -> protocol.Access.Level
+	6,  // 22: protocol.Access.tunnel:type_name -> protocol.Access.Level
+	10, // 23: protocol.MessageFromWrapClient.tcp_write_result:type_name -> protocol.TcpWriteResultMessage
+	12, // 24: protocol.MessageFromWrapClient.tcp_read_result:type_name -> protocol.TcpReadResultMessage
+	8,  // 25: protocol.MessageFromWrapClient.tcp_dial_result:type_name -> protocol.TcpDialResultMessage
+	13, // 26: protocol.MessageFromWrapClient.terminal_data:type_name -> protocol.TerminalData
+	67, // 27: protocol.MessageFromWrapClient.hello:type_name -> protocol.Hello
+	20, // 28: protocol.MessageFromWrapClient.file_read_result:type_name -> protocol.FileReadResult
+	28, // 29: protocol.MessageFromWrapClient.file_read_dir_result:type_name -> protocol.FileReadDirResult
+	58, // 30: protocol.MessageFromWrapClient.command_output:type_name -> protocol.CommandOutput
+	60, // 31: protocol.MessageFromWrapClient.test_report:type_name -> protocol.TestReport
+	62, // 32: protocol.MessageFromWrapClient.test_attempts:type_name -> protocol.TestAttempts
+	18, // 33: protocol.MessageFromWrapClient.terminal_close:type_name -> protocol.TerminalClose
+	17, // 34: protocol.MessageFromWrapClient.terminal_recording:type_name -> protocol.TerminalRecording
+	64, // 35: protocol.MessageFromWrapClient.rerun_output:type_name -> protocol.RerunOutput
+	65, // 36: protocol.MessageFromWrapClient.rerun_result:type_name -> protocol.RerunResult
+	35, // 37: protocol.MessageFromWrapClient.file_edit_result:type_name -> protocol.FileEditResult
+	22, // 38: protocol.MessageFromWrapClient.file_chunk:type_name -> protocol.FileChunk
+	24, // 39: protocol.MessageFromWrapClient.dir_archive_progress:type_name -> protocol.DirArchiveProgress
+	40, // 40: protocol.MessageFromWrapClient.file_upload_result:type_name -> protocol.FileUploadResult
+	46, // 41: protocol.MessageFromWrapClient.file_search_result:type_name -> protocol.FileSearchResult
+	49, // 42: protocol.MessageFromWrapClient.git_status_result:type_name -> protocol.GitStatusResult
+	51, // 43: protocol.MessageFromWrapClient.git_diff_result:type_name -> protocol.GitDiffResult
+	54, // 44: protocol.MessageFromWrapClient.git_blame_result:type_name -> protocol.GitBlameResult
+	56, // 45: protocol.MessageFromWrapClient.export_patch_result:type_name -> protocol.ExportPatchResult
+	9,  // 46: protocol.MessageToWrapClient.tcp_write_call:type_name -> protocol.TcpWriteMessage
+	11, // 47: protocol.MessageToWrapClient.tcp_read_call:type_name -> protocol.TcpReadMessage
+	7,  // 48: protocol.MessageToWrapClient.tcp_dial_call:type_name -> protocol.TcpDialMessage
+	13, // 49: protocol.MessageToWrapClient.terminal_write:type_name -> protocol.TerminalData
+	14, // 50: protocol.MessageToWrapClient.terminal_width:type_name -> protocol.TerminalWidth
+	19, // 51: protocol.MessageToWrapClient.file_read:type_name -> protocol.FileRead
+	26, // 52: protocol.MessageToWrapClient.file_read_dir:type_name -> protocol.FileReadDir
+	69, // 53: protocol.MessageToWrapClient.hello_response:type_name -> protocol.HelloResponse
+	16, // 54: protocol.MessageToWrapClient.terminal_open:type_name -> protocol.TerminalOpen
+	18, // 55: protocol.MessageToWrapClient.terminal_close:type_name -> protocol.TerminalClose
+	15, // 56: protocol.MessageToWrapClient.terminal_resize:type_name -> protocol.TerminalResize
+	63, // 57: protocol.MessageToWrapClient.rerun_command:type_name -> protocol.RerunCommand
+	29, // 58: protocol.MessageToWrapClient.file_write:type_name -> protocol.FileWrite
+	30, // 59: protocol.MessageToWrapClient.file_create:type_name -> protocol.FileCreate
+	31, // 60: protocol.MessageToWrapClient.file_delete:type_name -> protocol.FileDelete
+	32, // 61: protocol.MessageToWrapClient.file_rename:type_name -> protocol.FileRename
+	33, // 62: protocol.MessageToWrapClient.make_dir:type_name -> protocol.MakeDir
+	21, // 63: protocol.MessageToWrapClient.file_download:type_name -> protocol.FileDownload
+	25, // 64: protocol.MessageToWrapClient.file_chunk_ack:type_name -> protocol.FileChunkAck
+	23, // 65: protocol.MessageToWrapClient.dir_archive:type_name -> protocol.DirArchive
+	36, // 66: protocol.MessageToWrapClient.file_upload_begin:type_name -> protocol.FileUploadBegin
+	37, // 67: protocol.MessageToWrapClient.file_upload_chunk:type_name -> protocol.FileUploadChunk
+	38, // 68: protocol.MessageToWrapClient.file_upload_commit:type_name -> protocol.FileUploadCommit
+	39, // 69: protocol.MessageToWrapClient.file_upload_abort:type_name -> protocol.FileUploadAbort
+	41, // 70: protocol.MessageToWrapClient.file_search:type_name -> protocol.FileSearch
+	42, // 71: protocol.MessageToWrapClient.file_grep:type_name -> protocol.FileGrep
+	43, // 72: protocol.MessageToWrapClient.file_search_cancel:type_name -> protocol.FileSearchCancel
+	47, // 73: protocol.MessageToWrapClient.git_status:type_name -> protocol.GitStatus
+	50, // 74: protocol.MessageToWrapClient.git_diff:type_name -> protocol.GitDiff
+	52, // 75: protocol.MessageToWrapClient.git_blame:type_name -> protocol.GitBlame
+	55, // 76: protocol.MessageToWrapClient.export_patch:type_name -> protocol.ExportPatch
+	77, // [77:77] is the sub-list for method output_type
+	77, // [77:77] is the sub-list for method input_type
+	77, // [77:77] is the sub-list for extension type_name
+	77, // [77:77] is the sub-list for extension extendee
+	0,  // [0:77] is the sub-list for field type_name
+}
+
+func init() { file_WrapperMessage_proto_init() }
+func file_WrapperMessage_proto_init() {
+	if File_WrapperMessage_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_WrapperMessage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TcpDialMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TcpDialResultMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TcpWriteMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TcpWriteResultMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TcpReadMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TcpReadResultMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalWidth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalResize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalOpen); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalRecording); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalClose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileRead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileReadResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileDownload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirArchive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirArchiveProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileChunkAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileReadDir); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileReadDirResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileWrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileCreate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileDelete); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileRename); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1725,8 +7477,8 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TcpDialResultMessage); i {
+		file_WrapperMessage_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MakeDir); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1737,8 +7489,8 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TcpWriteMessage); i {
+		file_WrapperMessage_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1749,8 +7501,8 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TcpWriteResultMessage); i {
+		file_WrapperMessage_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileEditResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1761,8 +7513,8 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TcpReadMessage); i {
+		file_WrapperMessage_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileUploadBegin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1773,8 +7525,8 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TcpReadResultMessage); i {
+		file_WrapperMessage_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileUploadChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1785,8 +7537,8 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TerminalData); i {
+		file_WrapperMessage_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileUploadCommit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1797,8 +7549,8 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TerminalWidth); i {
+		file_WrapperMessage_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileUploadAbort); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1809,8 +7561,8 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileRead); i {
+		file_WrapperMessage_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileUploadResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1821,8 +7573,8 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileReadResult); i {
+		file_WrapperMessage_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileSearch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1833,8 +7585,8 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileReadDir); i {
+		file_WrapperMessage_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileGrep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1845,8 +7597,8 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DirEntry); i {
+		file_WrapperMessage_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileSearchCancel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1857,8 +7609,8 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileReadDirResult); i {
+		file_WrapperMessage_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1869,7 +7621,259 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_WrapperMessage_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileSearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitFileStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitStatusResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitDiffResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitBlame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitBlameLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitBlameResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandOutputChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestAttempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestAttempts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RerunCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RerunOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RerunResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Service); i {
 			case 0:
 				return &v.state
@@ -1881,7 +7885,7 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_WrapperMessage_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Hello); i {
 			case 0:
 				return &v.state
@@ -1893,7 +7897,19 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_WrapperMessage_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Access); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_WrapperMessage_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HelloResponse); i {
 			case 0:
 				return &v.state
@@ -1905,7 +7921,7 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_WrapperMessage_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MessageFromWrapClient); i {
 			case 0:
 				return &v.state
@@ -1917,7 +7933,7 @@ func file_WrapperMessage_proto_init() {
 				return nil
 			}
 		}
-		file_WrapperMessage_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_WrapperMessage_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MessageToWrapClient); i {
 			case 0:
 				return &v.state
@@ -1930,7 +7946,7 @@ func file_WrapperMessage_proto_init() {
 			}
 		}
 	}
-	file_WrapperMessage_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_WrapperMessage_proto_msgTypes[63].OneofWrappers = []interface{}{
 		(*MessageFromWrapClient_Error)(nil),
 		(*MessageFromWrapClient_TcpWriteResult)(nil),
 		(*MessageFromWrapClient_TcpReadResult)(nil),
@@ -1939,8 +7955,24 @@ func file_WrapperMessage_proto_init() {
 		(*MessageFromWrapClient_Hello)(nil),
 		(*MessageFromWrapClient_FileReadResult)(nil),
 		(*MessageFromWrapClient_FileReadDirResult)(nil),
+		(*MessageFromWrapClient_CommandOutput)(nil),
+		(*MessageFromWrapClient_TestReport)(nil),
+		(*MessageFromWrapClient_TestAttempts)(nil),
+		(*MessageFromWrapClient_TerminalClose)(nil),
+		(*MessageFromWrapClient_TerminalRecording)(nil),
+		(*MessageFromWrapClient_RerunOutput)(nil),
+		(*MessageFromWrapClient_RerunResult)(nil),
+		(*MessageFromWrapClient_FileEditResult)(nil),
+		(*MessageFromWrapClient_FileChunk)(nil),
+		(*MessageFromWrapClient_DirArchiveProgress)(nil),
+		(*MessageFromWrapClient_FileUploadResult)(nil),
+		(*MessageFromWrapClient_FileSearchResult)(nil),
+		(*MessageFromWrapClient_GitStatusResult)(nil),
+		(*MessageFromWrapClient_GitDiffResult)(nil),
+		(*MessageFromWrapClient_GitBlameResult)(nil),
+		(*MessageFromWrapClient_ExportPatchResult)(nil),
 	}
-	file_WrapperMessage_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_WrapperMessage_proto_msgTypes[64].OneofWrappers = []interface{}{
 		(*MessageToWrapClient_Error)(nil),
 		(*MessageToWrapClient_TcpWriteCall)(nil),
 		(*MessageToWrapClient_TcpReadCall)(nil),
@@ -1951,19 +7983,43 @@ func file_WrapperMessage_proto_init() {
 		(*MessageToWrapClient_FileReadDir)(nil),
 		(*MessageToWrapClient_HelloResponse)(nil),
 		(*MessageToWrapClient_Close)(nil),
+		(*MessageToWrapClient_TerminalOpen)(nil),
+		(*MessageToWrapClient_TerminalClose)(nil),
+		(*MessageToWrapClient_TerminalResize)(nil),
+		(*MessageToWrapClient_RerunCommand)(nil),
+		(*MessageToWrapClient_FileWrite)(nil),
+		(*MessageToWrapClient_FileCreate)(nil),
+		(*MessageToWrapClient_FileDelete)(nil),
+		(*MessageToWrapClient_FileRename)(nil),
+		(*MessageToWrapClient_MakeDir)(nil),
+		(*MessageToWrapClient_FileDownload)(nil),
+		(*MessageToWrapClient_FileChunkAck)(nil),
+		(*MessageToWrapClient_DirArchive)(nil),
+		(*MessageToWrapClient_FileUploadBegin)(nil),
+		(*MessageToWrapClient_FileUploadChunk)(nil),
+		(*MessageToWrapClient_FileUploadCommit)(nil),
+		(*MessageToWrapClient_FileUploadAbort)(nil),
+		(*MessageToWrapClient_FileSearch)(nil),
+		(*MessageToWrapClient_FileGrep)(nil),
+		(*MessageToWrapClient_FileSearchCancel)(nil),
+		(*MessageToWrapClient_GitStatus)(nil),
+		(*MessageToWrapClient_GitDiff)(nil),
+		(*MessageToWrapClient_GitBlame)(nil),
+		(*MessageToWrapClient_ExportPatch)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_WrapperMessage_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      7,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_WrapperMessage_proto_goTypes,
 		DependencyIndexes: file_WrapperMessage_proto_depIdxs,
+		EnumInfos:         file_WrapperMessage_proto_enumTypes,
 		MessageInfos:      file_WrapperMessage_proto_msgTypes,
 	}.Build()
 	File_WrapperMessage_proto = out.File
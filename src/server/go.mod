@@ -0,0 +1,18 @@
+module github.com/layer-devops/wrap.sh/src/server
+
+go 1.14
+
+require (
+	github.com/golang/protobuf v1.4.3
+	github.com/gorilla/websocket v1.4.2
+	github.com/layer-devops/wrap.sh/src/protocol v0.0.0-00010101000000-000000000000
+	github.com/pborman/getopt v1.1.0
+	github.com/pkg/errors v0.9.1
+	google.golang.org/protobuf v1.25.0
+)
+
+replace github.com/layer-devops/wrap.sh/src/protocol => ../protocol
+
+replace github.com/layer-devops/wrap.sh/src/server => ../server
+
+replace github.com/layer-devops/wrap.sh/src/wrap => ../wrap
@@ -0,0 +1,64 @@
+package main
+
+import (
+	server "github.com/layer-devops/wrap.sh/src/server/pkg"
+	"github.com/pborman/getopt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+)
+
+var debugLog = "false"
+
+func main() {
+	log.SetFlags(0)
+	listenFlag := getopt.StringLong("listen", 'l', ":8080", "The address to listen on")
+	urlFlag := getopt.StringLong("url", 'u', "", "The public URL of this server, used for dashboard links (defaults to http://<listen address>)")
+	tokenFlag := getopt.ListLong("token", 't', "Comma-separated wrap.sh authentication tokens to accept")
+	tokenFileFlag := getopt.StringLong("token-file", 'f', "", "A file containing authentication tokens to accept, one per line")
+	resumeTimeoutFlag := getopt.DurationLong("resume-timeout", 0, 0, "How long to wait for a disconnected client to resume its session (default 5m)")
+	recordingsFlag := getopt.StringLong("recordings-dir", 0, "", "A directory to keep terminal recordings uploaded by wrap clients in")
+	getopt.Parse()
+
+	tokens := map[string]bool{}
+	addTokens := func(list []string) {
+		for _, token := range list {
+			token = strings.TrimSpace(token)
+			if token != "" {
+				tokens[token] = true
+			}
+		}
+	}
+	addTokens(*tokenFlag)
+	if *tokenFileFlag != "" {
+		b, err := ioutil.ReadFile(*tokenFileFlag)
+		if err != nil {
+			log.Fatal("Could not read from the specified auth token file")
+		}
+		addTokens(strings.Split(string(b), "\n"))
+	}
+	addTokens(strings.Split(os.Getenv("WRAPSH_SERVER_TOKENS"), ","))
+
+	publicURL := *urlFlag
+	if publicURL == "" {
+		host := *listenFlag
+		if strings.HasPrefix(host, ":") {
+			host = "localhost" + host
+		}
+		publicURL = "http://" + host
+	}
+
+	//noinspection GoBoolExpressions
+	s := &server.Server{
+		PublicURL:           publicURL,
+		Tokens:              tokens,
+		LogDebug:            debugLog == "true",
+		ResumeTimeout:       *resumeTimeoutFlag,
+		RecordingsDirectory: *recordingsFlag,
+	}
+	if len(tokens) == 0 {
+		s.Log("No auth tokens configured, accepting every client.")
+	}
+	log.Fatal(s.ListenAndServe(*listenFlag))
+}
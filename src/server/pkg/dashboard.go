@@ -0,0 +1,162 @@
+package server
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+A dashboard is a browser (or any other tool) attached to a session.
+Messages are exchanged as binary protobufs, or as protobuf JSON text
+frames if the dashboard connected with ?encoding=json.
+*/
+type dashboard struct {
+	id         uint32
+	conn       *websocket.Conn
+	useJSON    bool
+	writeMutex sync.Mutex
+	closer     sync.Once
+}
+
+func (d *dashboard) read() (*protocol.MessageToWrapClient, error) {
+	messageType, b, err := d.conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+	msg := &protocol.MessageToWrapClient{}
+	switch messageType {
+	case websocket.BinaryMessage:
+		err = proto.Unmarshal(b, msg)
+	case websocket.TextMessage:
+		err = protojson.Unmarshal(b, msg)
+	default:
+		return nil, fmt.Errorf("unexpected message type: %v", messageType)
+	}
+	return msg, errors.Wrap(err, "could not parse message from dashboard")
+}
+
+func (d *dashboard) send(msg *protocol.MessageFromWrapClient) error {
+	d.writeMutex.Lock()
+	defer d.writeMutex.Unlock()
+	return d.write(msg)
+}
+
+/* writes a message to the dashboard, the caller must hold writeMutex */
+func (d *dashboard) write(msg *protocol.MessageFromWrapClient) error {
+	var b []byte
+	var err error
+	messageType := websocket.BinaryMessage
+	if d.useJSON {
+		messageType = websocket.TextMessage
+		b, err = protojson.Marshal(msg)
+	} else {
+		b, err = proto.Marshal(msg)
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not encode message to dashboard")
+	}
+	d.conn.SetWriteDeadline(time.Now().Add(time.Second * 10))
+	err = d.conn.WriteMessage(messageType, b)
+	return errors.Wrap(err, "could not write message to dashboard")
+}
+
+func (d *dashboard) close() {
+	d.closer.Do(func() {
+		_ = d.conn.Close()
+	})
+}
+
+/*
+Serves /session/<id> (the dashboard page) and
+/session/<id>/ws (the websocket the dashboard page talks to).
+*/
+func (server *Server) handleDashboard(w http.ResponseWriter, r *http.Request) {
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/session/"), "/", 2)
+	s := server.getSession(parts[0])
+	if s == nil {
+		http.NotFound(w, r)
+		return
+	}
+	if len(parts) == 1 || parts[1] == "" {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = io.WriteString(w, dashboardPage)
+		return
+	}
+	if parts[1] != "ws" {
+		http.NotFound(w, r)
+		return
+	}
+	conn, err := server.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		server.debugLog("could not upgrade dashboard connection: %v", err)
+		return
+	}
+	d := &dashboard{
+		conn:    conn,
+		useJSON: r.URL.Query().Get("encoding") == "json",
+	}
+	// hold back broadcasts until the dashboard has caught up
+	d.writeMutex.Lock()
+	history := s.addDashboard(d)
+	defer d.close()
+	defer s.removeDashboard(d)
+	server.debugLog("dashboard %v attached to session %v", d.id, s.ID)
+
+	// let the dashboard know which pipeline it's looking at, and what it missed
+	err = d.write(&protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_Hello{
+			Hello: s.hello(),
+		},
+	})
+	for _, msg := range history {
+		if err != nil {
+			break
+		}
+		err = d.write(msg)
+	}
+	d.writeMutex.Unlock()
+	if err != nil {
+		server.debugLog(err.Error())
+		return
+	}
+	for {
+		msg, err := d.read()
+		if err != nil {
+			server.debugLog("dashboard %v detached from session %v: %v", d.id, s.ID, err)
+			// the terminals it opened have nobody left to look at them
+			_ = s.send(&protocol.MessageToWrapClient{
+				Spec: &protocol.MessageToWrapClient_TerminalClose{
+					TerminalClose: &protocol.TerminalClose{},
+				},
+				ListenerId: d.id,
+			})
+			return
+		}
+		err = s.handleDashboardMessage(d, msg)
+		if err != nil {
+			_ = d.send(&protocol.MessageFromWrapClient{
+				Spec: &protocol.MessageFromWrapClient_Error{
+					Error: err.Error(),
+				},
+			})
+		}
+	}
+}
+
+func (s *session) handleDashboardMessage(d *dashboard, msg *protocol.MessageToWrapClient) error {
+	if msg.GetHelloResponse() != nil {
+		return errors.New("hello responses may only be sent by the server")
+	}
+	// replies from the wrap client are routed back to this dashboard only
+	msg.ListenerId = d.id
+	return s.send(msg)
+}
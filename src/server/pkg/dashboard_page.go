@@ -0,0 +1,125 @@
+package server
+
+/*
+A deliberately minimal dashboard: shows the pipeline metadata and a
+line-based terminal with its own shell. Richer dashboards can talk to /session/<id>/ws directly.
+*/
+const dashboardPage = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>wrap.sh</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+#info { color: #555; }
+#output { background: #111; color: #eee; padding: 1em; height: 60vh; overflow-y: scroll; white-space: pre-wrap; }
+#input { width: 85%; font-family: monospace; }
+</style>
+</head>
+<body>
+<h1>wrap.sh</h1>
+<div id="info">Connecting...</div>
+<pre id="output"></pre>
+<input id="input" placeholder="Type a command and press enter" autofocus>
+<button id="rerun">Re-run the command</button>
+<script>
+(function () {
+  var info = document.getElementById("info");
+  var output = document.getElementById("output");
+  var input = document.getElementById("input");
+  var rerun = document.getElementById("rerun"), rerunId = 0;
+  var scheme = location.protocol === "https:" ? "wss://" : "ws://";
+  var ws = new WebSocket(scheme + location.host + location.pathname.replace(/\/$/, "") + "/ws?encoding=json");
+  var decoder = new TextDecoder();
+  // this page's own shell, so that several people can use the dashboard at once
+  var terminalSession = 1, terminalOpen = false;
+  function openTerminal() {
+    ws.send(JSON.stringify({terminalOpen: {sessionId: terminalSession}}));
+    terminalOpen = true;
+  }
+  function decode(b64) {
+    var bin = atob(b64), bytes = new Uint8Array(bin.length);
+    for (var i = 0; i < bin.length; i++) bytes[i] = bin.charCodeAt(i);
+    return decoder.decode(bytes, {stream: true});
+  }
+  // this page doesn't emulate a terminal
+  function stripAnsi(str) {
+    return str.replace(/\x1b\[[0-9;?]*[A-Za-z]|\x1b\][^\x07]*\x07|\r/g, "");
+  }
+  function encode(str) {
+    var bytes = new TextEncoder().encode(str), bin = "";
+    for (var i = 0; i < bytes.length; i++) bin += String.fromCharCode(bytes[i]);
+    return btoa(bin);
+  }
+  ws.onmessage = function (e) {
+    var msg = JSON.parse(e.data);
+    if (msg.hello) {
+      var h = msg.hello;
+      info.textContent = [h.ciProvider, h.slug, h.branchName, h.commitHash, h.workingDirectory].filter(Boolean).join(" · ");
+      var terminalAccess = (h.access && h.access.terminal) || "FULL";
+      if (terminalAccess !== "FULL") {
+        // watch the shared terminal instead
+        terminalSession = 0;
+        input.disabled = true;
+        input.placeholder = terminalAccess === "READ_ONLY" ? "The terminal is read-only" : "The terminal is disabled";
+        rerun.disabled = true;
+      } else if (!terminalOpen) {
+        openTerminal();
+      }
+    } else if (msg.commandOutput) {
+      if (msg.commandOutput.truncated && !output.textContent) output.textContent = "[wrap.sh] (earlier output truncated)\n";
+      (msg.commandOutput.chunk || []).forEach(function (c) {
+        output.textContent += decode(c.data || "");
+      });
+    } else if (msg.testReport) {
+      var r = msg.testReport;
+      output.textContent += "\n[wrap.sh] " + (r.failed || 0) + " of " + (r.total || 0) + " test(s) failed\n";
+      (r.failure || []).forEach(function (f) {
+        output.textContent += "[wrap.sh]   " + f.name + (f.file ? " (" + f.file + ":" + (f.line || 0) + ")" : "") + ": " + (f.message || "") + "\n";
+      });
+    } else if (msg.testAttempts) {
+      var flaky = msg.testAttempts.flakyTest || [];
+      output.textContent += "[wrap.sh] " + (msg.testAttempts.attempt || []).length + " attempt(s)" +
+        (flaky.length ? ", flaky tests: " + flaky.join(", ") : "") + "\n";
+    } else if (msg.terminalData) {
+      if ((msg.terminalData.sessionId || 0) !== terminalSession) return;
+      output.textContent += stripAnsi(decode(msg.terminalData.data || ""));
+      output.scrollTop = output.scrollHeight;
+    } else if (msg.terminalClose) {
+      if ((msg.terminalClose.sessionId || 0) !== terminalSession) return;
+      terminalOpen = false;
+      output.textContent += "\n[wrap.sh] The shell exited, press enter to start a new one.\n";
+    } else if (msg.rerunOutput) {
+      output.textContent += stripAnsi(decode(msg.rerunOutput.data || ""));
+      output.scrollTop = output.scrollHeight;
+    } else if (msg.rerunResult) {
+      var result = msg.rerunResult;
+      output.textContent += "\n[wrap.sh] " + (result.error ? "Could not re-run the command: " + result.error :
+        (result.timedOut ? "Timed out" : "Exit code " + (result.exitCode || 0)) + " after " + (result.durationMs || 0) + "ms") + "\n";
+      rerun.disabled = false;
+    } else if (msg.error) {
+      output.textContent += "\n[wrap.sh] " + msg.error + "\n";
+    }
+  };
+  ws.onclose = function () {
+    info.textContent += " (disconnected)";
+  };
+  rerun.onclick = function () {
+    rerun.disabled = true;
+    output.textContent += "\n[wrap.sh] Re-running the command...\n";
+    ws.send(JSON.stringify({rerunCommand: {rerunId: ++rerunId}}));
+  };
+  input.onkeydown = function (e) {
+    if (e.key !== "Enter") return;
+    if (!terminalOpen) {
+      openTerminal();
+      if (!input.value) return;
+    }
+    ws.send(JSON.stringify({terminalWrite: {data: encode(input.value + "\n"), sessionId: terminalSession}}));
+    input.value = "";
+  };
+})();
+</script>
+</body>
+</html>
+`
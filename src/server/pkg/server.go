@@ -0,0 +1,173 @@
+package server
+
+import (
+	"crypto/subtle"
+	"github.com/gorilla/websocket"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultResumeTimeout = 5 * time.Minute
+
+/*
+Server accepts connections from wrap clients running in CI pipelines
+and brokers their traffic to the dashboard users debugging them.
+*/
+type Server struct {
+	// Base URL used when handing dashboard links to wrap clients, e.g. https://wrap.example.com
+	PublicURL string
+	LogDebug  bool
+
+	/*
+		Authentication tokens accepted in the X-Wrap-Auth-Token header.
+		If empty, every client is accepted.
+	*/
+	Tokens map[string]bool
+
+	// How long a session is kept for its wrap client to reconnect after the connection drops
+	ResumeTimeout time.Duration
+
+	// Terminal recordings uploaded by wrap clients are kept here, by session id, if it's set
+	RecordingsDirectory string
+
+	sessionsMutex sync.Mutex
+	sessions      map[string]*session
+
+	upgrader websocket.Upgrader
+}
+
+func (server *Server) debugLog(format string, args ...interface{}) {
+	if server.LogDebug {
+		log.Printf("[debug] "+format+"\n", args...)
+	}
+}
+
+func (server *Server) Log(format string, args ...interface{}) {
+	log.Printf("[wrap-server] "+format+"\n", args...)
+}
+
+/* returns the http handler serving both wrap clients and dashboards */
+func (server *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/"+protocol.WrapServerPath, server.handleWrapClient)
+	mux.HandleFunc("/session/", server.handleDashboard)
+	return mux
+}
+
+func (server *Server) ListenAndServe(address string) error {
+	server.Log("Listening on %v", address)
+	return http.ListenAndServe(address, server.Handler())
+}
+
+func (server *Server) isAuthorized(token string) bool {
+	if len(server.Tokens) == 0 {
+		return true
+	}
+	return server.Tokens[token]
+}
+
+func (server *Server) dashboardURL(sessionId string) string {
+	return strings.TrimSuffix(server.PublicURL, "/") + "/session/" + sessionId
+}
+
+func (server *Server) addSession(s *session) {
+	server.sessionsMutex.Lock()
+	defer server.sessionsMutex.Unlock()
+	if server.sessions == nil {
+		server.sessions = map[string]*session{}
+	}
+	server.sessions[s.ID] = s
+}
+
+func (server *Server) removeSession(s *session) {
+	server.sessionsMutex.Lock()
+	defer server.sessionsMutex.Unlock()
+	if server.sessions[s.ID] == s {
+		delete(server.sessions, s.ID)
+	}
+}
+
+func (server *Server) getSession(id string) *session {
+	server.sessionsMutex.Lock()
+	defer server.sessionsMutex.Unlock()
+	return server.sessions[id]
+}
+
+func (server *Server) handleWrapClient(w http.ResponseWriter, r *http.Request) {
+	if !server.isAuthorized(r.Header.Get(protocol.WrapAuthHeaderName)) {
+		// wrap clients treat a 404 as an authentication failure
+		server.debugLog("rejected client from %v: bad auth token", r.RemoteAddr)
+		http.NotFound(w, r)
+		return
+	}
+	conn, err := server.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		server.debugLog("could not upgrade client connection: %v", err)
+		return
+	}
+	server.debugLog("wrap client connected from %v", r.RemoteAddr)
+	// every connection starts with a hello, which may ask to resume an existing session
+	msg, err := readClientMessage(conn)
+	if err != nil {
+		server.debugLog("could not read hello: %v", err)
+		_ = conn.Close()
+		return
+	}
+	hello := msg.GetHello()
+	if hello == nil {
+		server.debugLog("first message from %v was not a hello", r.RemoteAddr)
+		_ = conn.Close()
+		return
+	}
+	s := server.getSession(hello.GetSessionId())
+	if s != nil && subtle.ConstantTimeCompare([]byte(hello.GetResumeToken()), []byte(s.resumeToken)) != 1 {
+		// the session id is in the dashboard URL, so it alone doesn't prove this is the session's client
+		server.Log("Refused to resume session %v from %v: bad resume token", s.ID, r.RemoteAddr)
+		_ = conn.Close()
+		return
+	}
+	if s != nil && s.attach(conn, hello) {
+		server.Log("Resumed session %v", s.ID)
+	} else {
+		s, err = server.newSession()
+		if err != nil {
+			server.Log(errors.Wrap(err, "create session").Error())
+			_ = conn.Close()
+			return
+		}
+		s.attach(conn, hello)
+		server.Log("Started session %v", s.ID)
+	}
+	s.listen(conn)
+}
+
+func (server *Server) newSession() (*session, error) {
+	id, err := newSessionId()
+	if err != nil {
+		return nil, errors.Wrap(err, "generate session id")
+	}
+	resumeToken, err := newSessionId()
+	if err != nil {
+		return nil, errors.Wrap(err, "generate resume token")
+	}
+	s := &session{
+		ID:          id,
+		resumeToken: resumeToken,
+		server:      server,
+		dashboards:  map[uint32]*dashboard{},
+	}
+	server.addSession(s)
+	return s, nil
+}
+
+func (server *Server) resumeTimeout() time.Duration {
+	if server.ResumeTimeout == 0 {
+		return defaultResumeTimeout
+	}
+	return server.ResumeTimeout
+}
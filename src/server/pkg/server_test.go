@@ -0,0 +1,211 @@
+package server
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func dialTestServer(t *testing.T, url string, token string) (*websocket.Conn, *http.Response, error) {
+	header := http.Header{}
+	header.Set(protocol.WrapAuthHeaderName, token)
+	return websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(url, "http"), header)
+}
+
+func writeTestMessage(t *testing.T, conn *websocket.Conn, msg proto.Message) {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = conn.WriteMessage(websocket.BinaryMessage, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readTestMessage(t *testing.T, conn *websocket.Conn, msg proto.Message) {
+	_, b, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = proto.Unmarshal(b, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newTestServer() (*Server, *httptest.Server) {
+	s := &Server{Tokens: map[string]bool{"secret": true}}
+	ts := httptest.NewServer(s.Handler())
+	s.PublicURL = ts.URL
+	return s, ts
+}
+
+func TestRejectsBadToken(t *testing.T) {
+	_, ts := newTestServer()
+	defer ts.Close()
+	_, response, err := dialTestServer(t, ts.URL+"/"+protocol.WrapServerPath, "wrong")
+	if err == nil {
+		t.Fatal("Expected dial to fail")
+	}
+	if response == nil || response.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected a 404 response, got %v", response)
+	}
+}
+
+func TestBrokersMessages(t *testing.T) {
+	_, ts := newTestServer()
+	defer ts.Close()
+	client, _, err := dialTestServer(t, ts.URL+"/"+protocol.WrapServerPath, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	writeTestMessage(t, client, &protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_Hello{
+			Hello: &protocol.Hello{Slug: "org/repo"},
+		},
+	})
+	helloResponse := &protocol.MessageToWrapClient{}
+	readTestMessage(t, client, helloResponse)
+	dashboardURL := helloResponse.GetHelloResponse().GetDashboardUrl()
+	if !strings.HasPrefix(dashboardURL, ts.URL+"/session/") {
+		t.Fatalf("Unexpected dashboard URL %q", dashboardURL)
+	}
+
+	dash, _, err := dialTestServer(t, dashboardURL+"/ws", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dash.Close()
+	hello := &protocol.MessageFromWrapClient{}
+	readTestMessage(t, dash, hello)
+	if hello.GetHello().GetSlug() != "org/repo" {
+		t.Fatalf("Expected the dashboard to receive the session hello, got %v", hello)
+	}
+
+	// dashboard -> client, tagged with the dashboard's listener id
+	writeTestMessage(t, dash, &protocol.MessageToWrapClient{
+		Spec: &protocol.MessageToWrapClient_FileRead{
+			FileRead: &protocol.FileRead{Path: "/tmp"},
+		},
+	})
+	fileRead := &protocol.MessageToWrapClient{}
+	readTestMessage(t, client, fileRead)
+	if fileRead.GetFileRead().GetPath() != "/tmp" || fileRead.GetListenerId() == 0 {
+		t.Fatalf("Unexpected message forwarded to client: %v", fileRead)
+	}
+
+	// client -> dashboard
+	writeTestMessage(t, client, &protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_FileReadResult{
+			FileReadResult: &protocol.FileReadResult{Path: "/tmp"},
+		},
+		ListenerId: fileRead.GetListenerId(),
+	})
+	fileReadResult := &protocol.MessageFromWrapClient{}
+	readTestMessage(t, dash, fileReadResult)
+	if fileReadResult.GetFileReadResult().GetPath() != "/tmp" {
+		t.Fatalf("Unexpected message forwarded to dashboard: %v", fileReadResult)
+	}
+
+	// the client is told to close the terminals of dashboards which leave
+	dash.Close()
+	terminalClose := &protocol.MessageToWrapClient{}
+	readTestMessage(t, client, terminalClose)
+	if terminalClose.GetTerminalClose() == nil || terminalClose.GetListenerId() != fileRead.GetListenerId() {
+		t.Fatalf("Expected a terminal close for the dashboard, got %v", terminalClose)
+	}
+}
+
+func TestResumesSession(t *testing.T) {
+	_, ts := newTestServer()
+	defer ts.Close()
+	hello := func(sessionId string, resumeToken string) *protocol.HelloResponse {
+		client, _, err := dialTestServer(t, ts.URL+"/"+protocol.WrapServerPath, "secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer client.Close()
+		writeTestMessage(t, client, &protocol.MessageFromWrapClient{
+			Spec: &protocol.MessageFromWrapClient_Hello{
+				Hello: &protocol.Hello{SessionId: sessionId, ResumeToken: resumeToken},
+			},
+		})
+		_, b, err := client.ReadMessage()
+		if err != nil {
+			// refused
+			return nil
+		}
+		response := &protocol.MessageToWrapClient{}
+		err = proto.Unmarshal(b, response)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return response.GetHelloResponse()
+	}
+	first := hello("", "")
+	if first.GetSessionId() == "" || first.GetResumeToken() == "" {
+		t.Fatal("Expected a session id and resume token in the hello response")
+	}
+	// the session id is in the dashboard URL, it isn't enough to take the session over
+	if stolen := hello(first.GetSessionId(), ""); stolen != nil {
+		t.Fatalf("Expected resuming without the resume token to be refused, got %v", stolen)
+	}
+	// the first connection was dropped without a close frame, so the session can be resumed
+	resumed := hello(first.GetSessionId(), first.GetResumeToken())
+	if resumed.GetDashboardUrl() != first.GetDashboardUrl() {
+		t.Fatalf("Expected dashboard URL %q, got %q", first.GetDashboardUrl(), resumed.GetDashboardUrl())
+	}
+	fresh := hello("unknown", "")
+	if fresh.GetDashboardUrl() == first.GetDashboardUrl() {
+		t.Fatal("Expected a new session for an unknown session id")
+	}
+}
+
+func TestHistoryIsCapped(t *testing.T) {
+	s := &session{}
+	s.addToHistory(&protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_TestReport{
+			TestReport: &protocol.TestReport{},
+		},
+	})
+	data := make([]byte, maxHistorySize/4)
+	for i := 0; i < 10; i++ {
+		s.addToHistory(&protocol.MessageFromWrapClient{
+			Spec: &protocol.MessageFromWrapClient_CommandOutput{
+				CommandOutput: &protocol.CommandOutput{
+					Chunk: []*protocol.CommandOutputChunk{{Data: data}},
+				},
+			},
+		})
+	}
+	if s.historySize > maxHistorySize {
+		t.Fatalf("Expected the history to be at most %v bytes, got %v", maxHistorySize, s.historySize)
+	}
+	// the oldest output is dropped before the test report
+	if s.history[0].GetTestReport() == nil || len(s.history) != 4 {
+		t.Fatalf("Expected the test report and the 3 newest chunks, got %v messages", len(s.history))
+	}
+
+	// test results are kept even once no output is left to drop
+	s = &session{}
+	details := strings.Repeat("x", maxHistorySize/2)
+	for i := 0; i < 3; i++ {
+		s.addToHistory(&protocol.MessageFromWrapClient{
+			Spec: &protocol.MessageFromWrapClient_TestReport{
+				TestReport: &protocol.TestReport{
+					Failure: []*protocol.TestFailure{{Details: details}},
+				},
+			},
+		})
+	}
+	if len(s.history) != 3 {
+		t.Fatalf("Expected the 3 test reports to be kept, got %v messages", len(s.history))
+	}
+}
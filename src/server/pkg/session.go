@@ -0,0 +1,340 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+/*
+A session is a single wrap client along with the dashboards currently
+attached to it. It outlives dropped client connections for a while,
+so that a reconnecting client gets the same dashboard back.
+*/
+type session struct {
+	ID string
+	// replaced when the client resumes the session, guarded by writeMutex
+	Hello *protocol.Hello
+	// only the wrap client which was given this can resume the session, see HelloResponse
+	resumeToken string
+
+	server *Server
+
+	// Websocket to the wrap client, nil while waiting for it to reconnect
+	conn       *websocket.Conn
+	writeMutex sync.Mutex
+	closed     bool
+	// incremented on every (re)attach, so stale resume timers can tell they're stale
+	generation int
+
+	// messages replayed to dashboards when they attach, e.g. the test command output
+	history []*protocol.MessageFromWrapClient
+	// the encoded size of history, which is kept under maxHistorySize
+	historySize int
+
+	// Dashboards, keyed by the listener id used to route replies
+	dashboardsMutex sync.Mutex
+	dashboards      map[uint32]*dashboard
+	nextListenerId  uint32
+}
+
+// the most bytes of messages kept to replay to dashboards, like the wrap client's command output buffer
+const maxHistorySize = 10 * 1024 * 1024
+
+func newSessionId() (string, error) {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func readClientMessage(conn *websocket.Conn) (*protocol.MessageFromWrapClient, error) {
+	messageType, b, err := conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+	if messageType != websocket.BinaryMessage {
+		return nil, fmt.Errorf("unexpected message type: %v", messageType)
+	}
+	msg := &protocol.MessageFromWrapClient{}
+	err = proto.Unmarshal(b, msg)
+	return msg, errors.Wrap(err, "could not parse message from wrap client")
+}
+
+func (s *session) send(msg *protocol.MessageToWrapClient) error {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+	if s.closed || s.conn == nil {
+		return errors.New("wrap client disconnected")
+	}
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "could not encode message to wrap client")
+	}
+	s.conn.SetWriteDeadline(time.Now().Add(time.Second * 10))
+	err = s.conn.WriteMessage(websocket.BinaryMessage, b)
+	return errors.Wrap(err, "could not write message to wrap client")
+}
+
+/* returns the hello of the client's latest connection */
+func (s *session) hello() *protocol.Hello {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+	return s.Hello
+}
+
+/*
+Makes conn the session's connection to its wrap client, and answers the client's hello.
+Returns false if the session has already ended.
+*/
+func (s *session) attach(conn *websocket.Conn, hello *protocol.Hello) bool {
+	s.writeMutex.Lock()
+	if s.closed {
+		s.writeMutex.Unlock()
+		return false
+	}
+	if s.conn != nil {
+		_ = s.conn.Close()
+	}
+	resumed := s.Hello != nil
+	s.conn = conn
+	s.Hello = hello
+	s.generation++
+	s.writeMutex.Unlock()
+
+	err := s.send(&protocol.MessageToWrapClient{
+		Spec: &protocol.MessageToWrapClient_HelloResponse{
+			HelloResponse: &protocol.HelloResponse{
+				DashboardUrl: s.server.dashboardURL(s.ID),
+				SessionId:    s.ID,
+				ResumeToken:  s.resumeToken,
+			},
+		},
+	})
+	if err != nil {
+		s.server.debugLog("could not send hello response: %v", err)
+	}
+	if resumed {
+		// lets dashboards know the client is back
+		s.forwardToDashboards(&protocol.MessageFromWrapClient{
+			Spec: &protocol.MessageFromWrapClient_Hello{
+				Hello: hello,
+			},
+		})
+	}
+	return true
+}
+
+/*
+Marks the session as waiting for its client to reconnect,
+ending it if the client doesn't come back in time.
+*/
+func (s *session) detach(conn *websocket.Conn) {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+	_ = conn.Close()
+	if s.conn != conn || s.closed {
+		// already replaced by a newer connection
+		return
+	}
+	s.conn = nil
+	generation := s.generation
+	time.AfterFunc(s.server.resumeTimeout(), func() {
+		s.writeMutex.Lock()
+		expired := s.generation == generation && s.conn == nil
+		s.writeMutex.Unlock()
+		if expired {
+			s.server.debugLog("session %v was not resumed in time", s.ID)
+			s.close()
+		}
+	})
+	go s.forwardToDashboards(&protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_Error{
+			Error: "wrap client disconnected, waiting for it to reconnect",
+		},
+	})
+}
+
+func (s *session) listen(conn *websocket.Conn) {
+	for {
+		msg, err := readClientMessage(conn)
+		if err != nil {
+			if websocket.IsCloseError(errors.Cause(err), websocket.CloseNormalClosure) {
+				// the client shut down on purpose, it won't be back
+				s.server.debugLog("wrap client for session %v closed the connection", s.ID)
+				s.close()
+				return
+			}
+			if errors.Cause(err) != io.EOF && !websocket.IsCloseError(errors.Cause(err),
+				websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				s.server.Log(errors.Wrap(err, "client connection error").Error())
+			}
+			s.server.debugLog("wrap client for session %v disconnected", s.ID)
+			s.detach(conn)
+			return
+		}
+		err = s.handleMessage(msg)
+		if err != nil {
+			s.server.Log(errors.Wrap(err, "handle client message").Error())
+		}
+	}
+}
+
+func (s *session) handleMessage(msg *protocol.MessageFromWrapClient) error {
+	if msg.GetHello() != nil {
+		return errors.New("duplicate hello")
+	}
+	// kept and broadcast under one lock, so a dashboard attaching meanwhile gets it exactly once
+	s.dashboardsMutex.Lock()
+	if msg.GetCommandOutput() != nil || msg.GetTestReport() != nil || msg.GetTestAttempts() != nil {
+		s.addToHistory(msg)
+	}
+	targets := s.targets(msg)
+	s.dashboardsMutex.Unlock()
+	if recording := msg.GetTerminalRecording(); recording != nil {
+		err := s.saveRecording(recording)
+		if err != nil {
+			s.server.Log("Could not save a recording for session %v: %v", s.ID, err)
+		}
+	}
+	s.sendToDashboards(msg, targets)
+	return nil
+}
+
+/*
+Keeps a message to replay to dashboards which attach later, the caller must
+hold dashboardsMutex. When the history grows past maxHistorySize the oldest
+command output is dropped, since the test results are small and matter most.
+They're always kept, even if that leaves the history bigger than that.
+*/
+func (s *session) addToHistory(msg *protocol.MessageFromWrapClient) {
+	s.history = append(s.history, msg)
+	s.historySize += proto.Size(msg)
+	for s.historySize > maxHistorySize {
+		drop := -1
+		for i, m := range s.history {
+			if m.GetCommandOutput() != nil {
+				drop = i
+				break
+			}
+		}
+		if drop < 0 {
+			// only test results are left
+			break
+		}
+		s.historySize -= proto.Size(s.history[drop])
+		s.history = append(s.history[:drop], s.history[drop+1:]...)
+	}
+}
+
+/* keeps an uploaded terminal recording in the server's recordings directory, if it has one */
+func (s *session) saveRecording(recording *protocol.TerminalRecording) error {
+	if s.server.RecordingsDirectory == "" {
+		return nil
+	}
+	name := filepath.Base(recording.GetName())
+	if name == "." || name == ".." || name == string(filepath.Separator) {
+		return fmt.Errorf("invalid recording name %q", recording.GetName())
+	}
+	dir := filepath.Join(s.server.RecordingsDirectory, s.ID)
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return errors.Wrap(err, "create recordings directory")
+	}
+	path := filepath.Join(dir, name)
+	err = ioutil.WriteFile(path, recording.GetData(), 0600)
+	if err != nil {
+		return errors.Wrap(err, "write recording")
+	}
+	s.server.Log("Saved a recording of session %v to %v", s.ID, path)
+	return nil
+}
+
+/*
+Sends a message from the wrap client to the dashboard which requested it,
+or to every attached dashboard if the message wasn't a reply (e.g. terminal output).
+*/
+func (s *session) forwardToDashboards(msg *protocol.MessageFromWrapClient) {
+	s.dashboardsMutex.Lock()
+	targets := s.targets(msg)
+	s.dashboardsMutex.Unlock()
+	s.sendToDashboards(msg, targets)
+}
+
+/* returns the dashboards a message is forwarded to, the caller must hold dashboardsMutex */
+func (s *session) targets(msg *protocol.MessageFromWrapClient) []*dashboard {
+	var targets []*dashboard
+	if listenerId := msg.GetListenerId(); listenerId != 0 {
+		if d, ok := s.dashboards[listenerId]; ok {
+			targets = append(targets, d)
+		}
+	} else {
+		for _, d := range s.dashboards {
+			targets = append(targets, d)
+		}
+	}
+	return targets
+}
+
+func (s *session) sendToDashboards(msg *protocol.MessageFromWrapClient, targets []*dashboard) {
+	for _, d := range targets {
+		err := d.send(msg)
+		if err != nil {
+			s.server.debugLog("dropping dashboard %v: %v", d.id, err)
+			d.close()
+		}
+	}
+}
+
+/* attaches a dashboard, returning the messages it missed so far */
+func (s *session) addDashboard(d *dashboard) []*protocol.MessageFromWrapClient {
+	s.dashboardsMutex.Lock()
+	defer s.dashboardsMutex.Unlock()
+	s.nextListenerId++
+	d.id = s.nextListenerId
+	s.dashboards[d.id] = d
+	return append([]*protocol.MessageFromWrapClient{}, s.history...)
+}
+
+func (s *session) removeDashboard(d *dashboard) {
+	s.dashboardsMutex.Lock()
+	defer s.dashboardsMutex.Unlock()
+	delete(s.dashboards, d.id)
+}
+
+func (s *session) close() {
+	s.writeMutex.Lock()
+	if s.closed {
+		s.writeMutex.Unlock()
+		return
+	}
+	s.closed = true
+	if s.conn != nil {
+		_ = s.conn.Close()
+	}
+	s.writeMutex.Unlock()
+	s.server.removeSession(s)
+	s.server.Log("Ended session %v", s.ID)
+	s.dashboardsMutex.Lock()
+	defer s.dashboardsMutex.Unlock()
+	for _, d := range s.dashboards {
+		_ = d.send(&protocol.MessageFromWrapClient{
+			Spec: &protocol.MessageFromWrapClient_Error{
+				Error: "wrap client disconnected",
+			},
+		})
+		d.close()
+	}
+}
@@ -10,6 +10,7 @@ require (
 	github.com/pborman/getopt v1.1.0
 	github.com/pkg/errors v0.9.1
 	golang.org/x/sys v0.0.0-20210119212857-b64e53b001e4 // indirect
+	google.golang.org/protobuf v1.25.0
 )
 
 replace github.com/layer-devops/wrap.sh/src/protocol => ../protocol
@@ -4,16 +4,53 @@ import (
 	"encoding/json"
 	"github.com/layer-devops/wrap.sh/src/protocol"
 	wrap "github.com/layer-devops/wrap.sh/src/wrap/pkg"
+	"github.com/layer-devops/wrap.sh/src/wrap/report"
 	"github.com/pborman/getopt"
 	"io/ioutil"
 	"log"
 	"os"
 	"strings"
+	"time"
 )
 
 var localDevBuild = "false"
 var debugLog = "false"
 
+/*
+Returns the duration at the given settings key, given either as a number
+of minutes or as a duration string such as "90s"
+*/
+func settingsDuration(settings map[string]interface{}, key string) time.Duration {
+	switch d := settings[key].(type) {
+	case float64:
+		return time.Duration(d * float64(time.Minute))
+	case string:
+		duration, err := time.ParseDuration(d)
+		if err != nil {
+			log.Fatalf("Could not parse %v from the settings file: %v", key, err)
+		}
+		return duration
+	}
+	return 0
+}
+
+/* returns the strings in the list at the given settings key */
+func settingsStringList(settings map[string]interface{}, key string) []string {
+	var result []string
+	if d, ok := settings[key]; ok {
+		entries, ok := d.([]interface{})
+		if ok {
+			for _, e := range entries {
+				entry, ok := e.(string)
+				if ok {
+					result = append(result, entry)
+				}
+			}
+		}
+	}
+	return result
+}
+
 func main() {
 	log.SetFlags(0)
 	wsLoc := "wss://" + protocol.ServerDomain + "/" + protocol.WrapServerPath
@@ -26,7 +63,10 @@ func main() {
 	authTokenFlag := getopt.StringLong("token", 't', "", "Your wrap.sh authentication token")
 	authFileFlag := getopt.StringLong("token-file", 'f', "", "A file containing your wrap.sh authentication token")
 	settingsFileFlag := getopt.StringLong("settings", 's', "", "A JSON file containing client settings")
+	serverFlag := getopt.StringLong("server", 0, "", "The wrap.sh server to connect to, e.g. wss://wrap.example.com/wrap")
 	retryFlag := getopt.IntLong("retry", 'r', -1, "Number of times to retry the command before failing.")
+	ptyFlag := getopt.BoolLong("pty", 'p', "Run the command in a pseudo-terminal, preserving colors and progress output")
+	failOnFlakyFlag := getopt.BoolLong("fail-on-flaky", 0, "Exit with a non-zero code if the command only passed after retrying")
 	getopt.Parse()
 	testCommand := ""
 	for _, arg := range getopt.Args() {
@@ -66,6 +106,27 @@ func main() {
 		}
 	}
 
+	// Point at a different server if one is specified in args, the environment or the settings
+	serverLocation := *serverFlag
+	if serverLocation == "" {
+		serverLocation = os.Getenv("WRAPSH_SERVER")
+	}
+	if serverLocation == "" {
+		if entry, ok := settings["Server"]; ok {
+			sl, ok := entry.(string)
+			if ok {
+				serverLocation = sl
+			}
+		}
+	}
+	if serverLocation != "" {
+		loc, err := wrap.ResolveServerLocation(serverLocation)
+		if err != nil {
+			log.Fatalf("Invalid server address %q: %v", serverLocation, err)
+		}
+		wsLoc = loc
+	}
+
 	// Pull list of redacted metadata fields from the settings
 	excludedTelemetryFields := map[string]bool{}
 	if d, ok := settings["ExcludedTelemetryFields"]; ok {
@@ -108,6 +169,54 @@ func main() {
 		}
 	}
 
+	// Set how long to keep trying to reconnect to the server if the connection drops.
+	// Negative values disable reconnecting
+	if t, ok := settings["ReconnectTimeout"]; ok {
+		timeout, ok := t.(float64)
+		if ok {
+			client.ReconnectTimeoutMinutes = int(timeout)
+		}
+	}
+
+	// Set how much of the test command's output is kept for the dashboard, in bytes
+	if b, ok := settings["OutputBufferSize"]; ok {
+		size, ok := b.(float64)
+		if ok {
+			client.CommandOutputBufferSize = int(size)
+		}
+	}
+
+	// Limit the size of files uploaded from the dashboard, in bytes
+	if b, ok := settings["MaxUploadSize"]; ok {
+		size, ok := b.(float64)
+		if ok {
+			client.MaxUploadSize = int64(size)
+		}
+	}
+
+	// Run the command in a pty if requested in args or the settings
+	client.UsePty = *ptyFlag
+	if !client.UsePty {
+		if entry, ok := settings["Pty"]; ok {
+			usePty, ok := entry.(bool)
+			if ok {
+				client.UsePty = usePty
+			}
+		}
+	}
+
+	// Pull the globs for test reports to parse when the command fails
+	client.TestReports = map[report.Format][]string{}
+	for key, format := range map[string]report.Format{
+		"JUnitReports":  report.JUnit,
+		"TAPReports":    report.TAP,
+		"GoTestReports": report.GoTest,
+	} {
+		if globs := settingsStringList(settings, key); len(globs) > 0 {
+			client.TestReports[format] = globs
+		}
+	}
+
 	// Check the settings for a retry policy if one wasn't specified in args
 	if *retryFlag == -1 {
 		if entry, ok := settings["NumRetries"]; ok {
@@ -120,6 +229,105 @@ func main() {
 		client.NumRetries = *retryFlag
 	}
 
+	// Check the settings for a command which retries only the failed tests
+	if entry, ok := settings["RetryCommand"]; ok {
+		rc, ok := entry.(string)
+		if ok {
+			client.RetryCommand = rc
+		}
+	}
+
+	// Set time limits for the command, if any
+	client.CommandTimeout = settingsDuration(settings, "CommandTimeout")
+	client.AttemptTimeout = settingsDuration(settings, "AttemptTimeout")
+	// Start the debug session early if an attempt runs longer than this
+	client.HangTimeout = settingsDuration(settings, "HangTimeout")
+
+	// Record terminal sessions, if requested
+	if entry, ok := settings["RecordingDirectory"]; ok {
+		dir, ok := entry.(string)
+		if ok {
+			client.RecordingDirectory = dir
+		}
+	}
+	if entry, ok := settings["UploadRecordings"]; ok {
+		upload, ok := entry.(bool)
+		if ok {
+			client.UploadRecordings = upload
+		}
+	}
+	if entry, ok := settings["RecordInput"]; ok {
+		record, ok := entry.(bool)
+		if ok {
+			client.SkipInputRecording = !record
+		}
+	}
+
+	// Configure the shell started in terminals
+	for key, value := range map[string]*string{
+		"Shell":          &client.Shell,
+		"ShellDirectory": &client.ShellDirectory,
+		"ShellRcFile":    &client.ShellRcFile,
+	} {
+		if entry, ok := settings[key]; ok {
+			s, ok := entry.(string)
+			if ok {
+				*value = s
+			}
+		}
+	}
+	client.ShellArgs = settingsStringList(settings, "ShellArgs")
+	if entry, ok := settings["ShellEnv"]; ok {
+		env, ok := entry.(map[string]interface{})
+		if ok {
+			client.ShellEnv = map[string]string{}
+			for key, value := range env {
+				if s, ok := value.(string); ok {
+					client.ShellEnv[key] = s
+				}
+			}
+		}
+	}
+
+	// Mask secrets in anything sent to the dashboard; an empty pattern list only masks Secrets and known token formats
+	client.Secrets = settingsStringList(settings, "Secrets")
+	if _, ok := settings["RedactEnvPatterns"]; ok {
+		client.RedactEnvPatterns = append([]string{}, settingsStringList(settings, "RedactEnvPatterns")...)
+	}
+
+	// Restrict what dashboard users may do, e.g. {"Terminal": "read-only", "Tunnel": "disabled"}
+	if entry, ok := settings["Access"]; ok {
+		access, ok := entry.(map[string]interface{})
+		if !ok {
+			log.Fatal("Access in the settings file must be an object")
+		}
+		for key, level := range map[string]*protocol.Access_Level{
+			"Terminal": &client.TerminalAccess,
+			"Files":    &client.FileAccess,
+			"Tunnel":   &client.TunnelAccess,
+		} {
+			if entry, ok := access[key]; ok {
+				s, ok := entry.(string)
+				l, err := wrap.ParseAccessLevel(s)
+				if !ok || err != nil {
+					log.Fatalf("Invalid Access.%v in the settings file, expected full, read-only or disabled", key)
+				}
+				*level = l
+			}
+		}
+	}
+
+	// Fail flaky runs if requested in args or the settings
+	client.FailOnFlaky = *failOnFlakyFlag
+	if !client.FailOnFlaky {
+		if entry, ok := settings["FailOnFlaky"]; ok {
+			failOnFlaky, ok := entry.(bool)
+			if ok {
+				client.FailOnFlaky = failOnFlaky
+			}
+		}
+	}
+
 	client.Run()
 	os.Exit(client.ExitCode)
 }
@@ -0,0 +1,110 @@
+package wrap
+
+import (
+	"fmt"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+/* parses an access level from the settings, e.g. "read-only" */
+func ParseAccessLevel(s string) (protocol.Access_Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "full":
+		return protocol.Access_FULL, nil
+	case "read-only", "readonly":
+		return protocol.Access_READ_ONLY, nil
+	case "disabled", "none":
+		return protocol.Access_DISABLED, nil
+	}
+	return protocol.Access_FULL, fmt.Errorf("unknown access level %q, expected full, read-only or disabled", s)
+}
+
+func (client *Client) access() *protocol.Access {
+	return &protocol.Access{
+		Terminal: client.TerminalAccess,
+		Files:    client.FileAccess,
+		Tunnel:   client.TunnelAccess,
+	}
+}
+
+/*
+Returns an error if dashboard users aren't allowed to send the given message.
+Messages which only read are allowed at the read-only level. Messages which
+aren't listed here are refused, so new ones have to be added.
+*/
+func (client *Client) checkAccess(message *protocol.MessageToWrapClient) error {
+	var feature string
+	var level protocol.Access_Level
+	readOnly := false
+	switch message.GetSpec().(type) {
+	case *protocol.MessageToWrapClient_Error,
+		*protocol.MessageToWrapClient_HelloResponse,
+		*protocol.MessageToWrapClient_Close,
+		*protocol.MessageToWrapClient_TerminalClose,
+		*protocol.MessageToWrapClient_FileChunkAck,
+		*protocol.MessageToWrapClient_FileSearchCancel:
+		// they only stop or acknowledge things
+		return nil
+	case *protocol.MessageToWrapClient_TcpWriteCall,
+		*protocol.MessageToWrapClient_TcpReadCall,
+		*protocol.MessageToWrapClient_TcpDialCall:
+		feature, level = "TCP tunnel", client.TunnelAccess
+		if level == protocol.Access_READ_ONLY {
+			// anything sent through a tunnel can have side effects
+			level = protocol.Access_DISABLED
+		}
+	case *protocol.MessageToWrapClient_TerminalWrite,
+		*protocol.MessageToWrapClient_TerminalWidth,
+		*protocol.MessageToWrapClient_TerminalResize,
+		*protocol.MessageToWrapClient_TerminalOpen,
+		*protocol.MessageToWrapClient_RerunCommand:
+		// reruns run commands just like the terminal
+		feature, level = "terminal", client.TerminalAccess
+	case *protocol.MessageToWrapClient_FileRead,
+		*protocol.MessageToWrapClient_FileReadDir,
+		*protocol.MessageToWrapClient_FileDownload,
+		*protocol.MessageToWrapClient_DirArchive,
+		*protocol.MessageToWrapClient_FileSearch,
+		*protocol.MessageToWrapClient_FileGrep,
+		*protocol.MessageToWrapClient_GitStatus,
+		*protocol.MessageToWrapClient_GitDiff,
+		*protocol.MessageToWrapClient_GitBlame:
+		feature, level = "file browser", client.FileAccess
+		readOnly = true
+	case *protocol.MessageToWrapClient_FileWrite,
+		*protocol.MessageToWrapClient_FileCreate,
+		*protocol.MessageToWrapClient_FileDelete,
+		*protocol.MessageToWrapClient_FileRename,
+		*protocol.MessageToWrapClient_MakeDir,
+		*protocol.MessageToWrapClient_FileUploadBegin,
+		*protocol.MessageToWrapClient_FileUploadChunk,
+		*protocol.MessageToWrapClient_FileUploadCommit,
+		*protocol.MessageToWrapClient_FileUploadAbort,
+		*protocol.MessageToWrapClient_ExportPatch:
+		// exporting a patch writes objects to the repository
+		feature, level = "file browser", client.FileAccess
+	default:
+		return errors.Errorf("access denied: unknown message %T", message.GetSpec())
+	}
+	switch {
+	case level == protocol.Access_DISABLED:
+		return errors.Errorf("access denied: the %v is disabled", feature)
+	case level == protocol.Access_READ_ONLY && !readOnly:
+		return errors.Errorf("access denied: the %v is read-only", feature)
+	}
+	return nil
+}
+
+/* replies with an error to the listener which sent a message */
+func (client *Client) sendError(err error, listenerId uint32) {
+	sendErr := client.send(&protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_Error{
+			Error: err.Error(),
+		},
+		ListenerId: listenerId,
+	})
+	if sendErr != nil {
+		client.debugLog(errors.Wrap(sendErr, "send error").Error())
+	}
+}
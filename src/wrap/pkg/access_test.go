@@ -0,0 +1,89 @@
+package wrap
+
+import (
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"testing"
+)
+
+func TestCheckAccess(t *testing.T) {
+	client := &Client{
+		TerminalAccess: protocol.Access_READ_ONLY,
+		FileAccess:     protocol.Access_READ_ONLY,
+		TunnelAccess:   protocol.Access_READ_ONLY,
+	}
+	terminalWrite := &protocol.MessageToWrapClient{
+		Spec: &protocol.MessageToWrapClient_TerminalWrite{TerminalWrite: &protocol.TerminalData{}},
+	}
+	fileRead := &protocol.MessageToWrapClient{
+		Spec: &protocol.MessageToWrapClient_FileRead{FileRead: &protocol.FileRead{}},
+	}
+	tcpDial := &protocol.MessageToWrapClient{
+		Spec: &protocol.MessageToWrapClient_TcpDialCall{TcpDialCall: &protocol.TcpDialMessage{}},
+	}
+	assertNotNil(t, "terminal write error", client.checkAccess(terminalWrite))
+	assertNil(t, "file read error", client.checkAccess(fileRead))
+	assertNotNil(t, "tcp dial error", client.checkAccess(tcpDial))
+	exportPatch := &protocol.MessageToWrapClient{
+		Spec: &protocol.MessageToWrapClient_ExportPatch{ExportPatch: &protocol.ExportPatch{}},
+	}
+	assertNotNil(t, "export patch error", client.checkAccess(exportPatch))
+
+	client.FileAccess = protocol.Access_DISABLED
+	assertNotNil(t, "file read error", client.checkAccess(fileRead))
+	client.TerminalAccess = protocol.Access_FULL
+	assertNil(t, "terminal write error", client.checkAccess(terminalWrite))
+}
+
+/* returns a message with each of the types dashboards can send */
+func everyMessageToWrapClient() []*protocol.MessageToWrapClient {
+	var messages []*protocol.MessageToWrapClient
+	fields := (&protocol.MessageToWrapClient{}).ProtoReflect().Descriptor().Oneofs().ByName("spec").Fields()
+	for i := 0; i < fields.Len(); i++ {
+		message := &protocol.MessageToWrapClient{}
+		m := message.ProtoReflect()
+		field := fields.Get(i)
+		if field.Kind() == protoreflect.MessageKind {
+			m.Set(field, protoreflect.ValueOfMessage(m.NewField(field).Message()))
+		} else {
+			m.Set(field, m.NewField(field))
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+func TestCheckAccessEveryMessage(t *testing.T) {
+	full := &Client{}
+	disabled := &Client{
+		TerminalAccess: protocol.Access_DISABLED,
+		FileAccess:     protocol.Access_DISABLED,
+		TunnelAccess:   protocol.Access_DISABLED,
+	}
+	allowed := map[string]bool{
+		"error": true, "hello_response": true, "close": true, "terminal_close": true,
+		"file_chunk_ack": true, "file_search_cancel": true,
+	}
+	messages := everyMessageToWrapClient()
+	assertEqual(t, "message types", 33, len(messages))
+	for _, message := range messages {
+		name := string(message.ProtoReflect().WhichOneof(message.ProtoReflect().Descriptor().Oneofs().ByName("spec")).Name())
+		// every message has to be known to checkAccess, or it's refused
+		assertNil(t, name+" error with full access", full.checkAccess(message))
+		err := disabled.checkAccess(message)
+		if allowed[name] {
+			assertNil(t, name+" error with access disabled", err)
+		} else {
+			assertNotNil(t, name+" error with access disabled", err)
+		}
+	}
+	assertNotNil(t, "empty message error", full.checkAccess(&protocol.MessageToWrapClient{}))
+}
+
+func TestParseAccessLevel(t *testing.T) {
+	level, err := ParseAccessLevel("Read-Only")
+	assertNil(t, "error", err)
+	assertEqual(t, "level", protocol.Access_READ_ONLY, level)
+	_, err = ParseAccessLevel("sometimes")
+	assertNotNil(t, "error", err)
+}
@@ -0,0 +1,100 @@
+package wrap
+
+import (
+	"fmt"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"strings"
+	"time"
+)
+
+type attemptResult struct {
+	number   int
+	exitCode int
+	duration time.Duration
+	timedOut bool
+	// from the test reports, if any were configured and found
+	failedTests []string
+	passedTests map[string]bool
+}
+
+func testName(suite string, name string) string {
+	if suite == "" {
+		return name
+	}
+	return suite + "." + name
+}
+
+/* returns the tests which failed in one attempt and passed in a later one */
+func flakyTests(attempts []*attemptResult) []string {
+	var flaky []string
+	found := map[string]bool{}
+	for i, attempt := range attempts {
+		for _, test := range attempt.failedTests {
+			if found[test] {
+				continue
+			}
+			for _, later := range attempts[i+1:] {
+				if later.exitCode == 0 || later.passedTests[test] {
+					found[test] = true
+					flaky = append(flaky, test)
+					break
+				}
+			}
+		}
+	}
+	return flaky
+}
+
+/* returns whether any attempt failed before the command eventually succeeded */
+func commandWasFlaky(attempts []*attemptResult) bool {
+	return len(attempts) > 1 && attempts[len(attempts)-1].exitCode == 0
+}
+
+func (client *Client) logAttempts() {
+	if len(client.attempts) < 2 {
+		return
+	}
+	client.Log("Attempt summary:")
+	for _, attempt := range client.attempts {
+		failed := ""
+		if len(attempt.failedTests) > 0 {
+			failed = ", failed: " + strings.Join(attempt.failedTests, ", ")
+		}
+		status := fmt.Sprintf("exit code %v", attempt.exitCode)
+		if attempt.timedOut {
+			status = "timed out"
+		}
+		client.Log("  #%v: %v after %v%v", attempt.number, status,
+			attempt.duration.Round(time.Millisecond), failed)
+	}
+	if flaky := flakyTests(client.attempts); len(flaky) > 0 {
+		client.Log("Flaky tests (failed, then passed): %v", strings.Join(flaky, ", "))
+	} else if commandWasFlaky(client.attempts) {
+		client.Log("The command is flaky: it failed, then passed.")
+	}
+}
+
+func (client *Client) sendAttempts() error {
+	if len(client.attempts) < 2 {
+		return nil
+	}
+	msg := &protocol.TestAttempts{
+		FlakyTest: flakyTests(client.attempts),
+	}
+	for _, attempt := range client.attempts {
+		msg.Attempt = append(msg.Attempt, &protocol.TestAttempt{
+			Attempt:    uint32(attempt.number),
+			ExitCode:   int32(attempt.exitCode),
+			DurationMs: uint64(attempt.duration / time.Millisecond),
+			FailedTest: attempt.failedTests,
+			TimedOut:   attempt.timedOut,
+		})
+	}
+	err := client.send(&protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_TestAttempts{
+			TestAttempts: msg,
+		},
+	})
+	return errors.Wrap(err, "send test attempts")
+}
@@ -0,0 +1,20 @@
+package wrap
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFlakyTests(t *testing.T) {
+	attempts := []*attemptResult{
+		{number: 1, exitCode: 1, failedTests: []string{"a", "b", "c"}},
+		{number: 2, exitCode: 1, failedTests: []string{"b"}, passedTests: map[string]bool{"a": true}},
+		{number: 3, exitCode: 0},
+	}
+	assertEqual(t, "flaky tests", "a,b,c", strings.Join(flakyTests(attempts), ","))
+	assertEqual(t, "command was flaky", true, commandWasFlaky(attempts))
+
+	attempts = attempts[:2]
+	assertEqual(t, "flaky tests", "a", strings.Join(flakyTests(attempts), ","))
+	assertEqual(t, "command was flaky", false, commandWasFlaky(attempts))
+}
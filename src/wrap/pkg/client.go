@@ -2,8 +2,10 @@ package wrap
 
 import (
 	"bytes"
+	"github.com/creack/pty"
 	"github.com/gorilla/websocket"
 	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/layer-devops/wrap.sh/src/wrap/report"
 	"github.com/pkg/errors"
 	"io"
 	"log"
@@ -11,20 +13,111 @@ import (
 	"os/exec"
 	"os/signal"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 )
 
+// the exit code of attempts which hit a time limit, as used by timeout(1)
+const timedOutExitCode = 124
+
+// time given to a timed out command between SIGTERM and SIGKILL, shortened in tests
+var killGracePeriod = 10 * time.Second
+
+// how long output is still copied once the command exits, background processes may keep it open
+const outputDrainTimeout = time.Second
+
+// how long closing the client waits for terminal recordings to be uploaded
+const terminalCloseTimeout = 30 * time.Second
+
+var commandTimedOutError = errors.New("command timed out")
+
 type Client struct {
 	TestCommand       string
 	Token             string
 	WebsocketLocation string
 	DashboardURL      string
-	UUID              string
-	LogDebug          bool
-	TimeoutMinutes    int
-	NumRetries        int
-	wasAccessed       bool
-	ExitCode          int
+	// session id assigned by the server, presented when reconnecting. Guarded by sessionMutex
+	UUID string
+	// proves the session is ours when reconnecting, see HelloResponse
+	resumeToken    string
+	sessionMutex   sync.Mutex
+	LogDebug       bool
+	TimeoutMinutes int
+	NumRetries     int
+	// run the test command in a pty instead of piping its output
+	UsePty bool
+	// set atomically once a dashboard user did something, messages are handled concurrently
+	wasAccessed int32
+	ExitCode    int
+
+	/*
+		How long to keep trying to reconnect after the connection
+		to the server drops. 0 uses the default, negative values
+		disable reconnecting.
+	*/
+	ReconnectTimeoutMinutes int
+
+	// How many bytes of test command output to keep for the dashboard
+	CommandOutputBufferSize int
+
+	// Globs for the test reports written by the command, by report format
+	TestReports map[report.Format][]string
+	// exit with the failed attempt's exit code if the command only passed on a retry
+	FailOnFlaky bool
+	/*
+		Template for the command run on retries, rendered with the failures
+		from the previous attempt's test report. Falls back to TestCommand.
+	*/
+	RetryCommand string
+
+	// time limits for all attempts together, and for each attempt. Zero means no limit
+	CommandTimeout time.Duration
+	AttemptTimeout time.Duration
+	/*
+		Soft time limit for each attempt. Once it's reached, the debug session
+		is started while the command is still running, so that it can be
+		inspected. Zero waits for the command to exit.
+	*/
+	HangTimeout time.Duration
+
+	/*
+		What dashboard users may do with the terminal, file browser and
+		TCP tunnels. Read-only terminals can be watched, but not typed into.
+	*/
+	TerminalAccess protocol.Access_Level
+	FileAccess     protocol.Access_Level
+	TunnelAccess   protocol.Access_Level
+
+	/*
+		The shell started in terminals, falling back to bash, then sh,
+		if it's not installed. Unless arguments are given, it's made to
+		show a banner about the failed command, and define some helpers.
+	*/
+	Shell     string
+	ShellArgs []string
+	// added to the environment terminals inherit
+	ShellEnv map[string]string
+	// the terminals' starting directory, defaults to the current one
+	ShellDirectory string
+	// sourced after wrap.sh's own rc file
+	ShellRcFile string
+
+	/*
+		Terminal sessions are recorded to this directory if it's set,
+		and uploaded to the wrap.sh server when they close if requested.
+	*/
+	RecordingDirectory string
+	UploadRecordings   bool
+	/*
+		Leaves what's typed into terminals out of recordings. It's recorded by
+		default, with secrets masked like terminal output, but anything pasted
+		which isn't a known secret ends up in the recording.
+	*/
+	SkipInputRecording bool
+
+	// Uploads from the dashboard bigger than this many bytes are refused, 0 uses the default
+	MaxUploadSize int64
 
 	/*
 		Privacy settings.
@@ -32,6 +125,15 @@ type Client struct {
 		are redacted before anything is sent.
 	*/
 	ExcludedTelemetryFields map[string]bool
+	/*
+		Values masked in terminal output, test command output and files
+		read from the dashboard, along with those of the environment
+		variables matching RedactEnvPatterns (defaults to names like
+		*TOKEN* or *SECRET*) and well-known token formats.
+	*/
+	Secrets           []string
+	RedactEnvPatterns []string
+	redactor          *redactor
 
 	// TCP
 	connMapWriteMutex sync.Mutex
@@ -40,13 +142,56 @@ type Client struct {
 	// Websocket
 	ws           *websocket.Conn
 	recvBuf      bytes.Buffer
-	closed       bool
+	closed       int32
 	closingMutex sync.Mutex
 	closedChan   chan struct{}
 	wsWriteMutex sync.Mutex
+	wsChanged    *sync.Cond
+	hello        *protocol.Hello
+
+	// tty sessions
+	terminalsMutex sync.Mutex
+	terminals      map[terminalKey]*terminal
+	terminalsDone  sync.WaitGroup
+
+	// file downloads in progress
+	downloadsMutex sync.Mutex
+	downloads      map[downloadKey]*download
+	// file uploads in progress
+	uploadsMutex sync.Mutex
+	uploads      map[uploadKey]*upload
+	// file searches in progress
+	searchesMutex sync.Mutex
+	searches      map[searchKey]*search
+	// files edited from the dashboard, see ExportPatch
+	editedPathsMutex sync.Mutex
+	editedPaths      map[string]bool
+
+	// test command output, across all attempts
+	commandOutput *commandOutput
+	// sequence number of the next output chunk to send to the server
+	commandOutputSeq uint64
+	// parsed test reports from the last attempt
+	testReport *protocol.TestReport
+	attempts   []*attemptResult
+	// whether the last attempt hit a time limit
+	commandTimedOut bool
+	// set atomically once all attempts are done, terminals may be open before that
+	commandFinished int32
+	// the test command's pid, if the debug session was started while it hung. Set atomically
+	hungPid int32
+
+	// dashboards which sent messages and haven't detached, by listener id
+	dashboardsMutex sync.Mutex
+	dashboards      map[uint32]bool
+	// set if the command passed while dashboards were attached
+	closeWhenUnattended bool
 
-	// tty
-	terminal *terminal
+	// the debug session may be started while the test command is still running
+	debugSessionMutex   sync.Mutex
+	debugSessionStarted bool
+	// untracked files changed since then are exported in patches
+	debugSessionStart time.Time
 }
 
 func (client *Client) debugLog(format string, args ...interface{}) {
@@ -59,75 +204,437 @@ func (client *Client) Log(format string, args ...interface{}) {
 	log.Printf("[wrap.sh] "+format+"\n", args...)
 }
 
-/* runs the provided test command and returns whether it succeeded. */
-func (client *Client) runTestCommand() (bool, error) {
-	testCmd := exec.Command("bash", "-c", client.TestCommand)
-	testCmd.Env = os.Environ()
-	stderrPipe, err := testCmd.StderrPipe()
+/*
+Runs the provided test command and returns whether it succeeded.
+The command is stopped if it's still running at the deadline, unless it's zero.
+*/
+func (client *Client) runTestCommand(command string, deadline time.Time) (bool, error) {
+	testCmd := testCommand(command)
+	client.Log("Running \"%v\"", command)
+	var err error
+	if client.UsePty {
+		err = client.runInPty(testCmd, deadline,
+			io.MultiWriter(os.Stdout, client.commandOutput.writer(protocol.CommandOutputChunk_TTY)))
+	} else {
+		// mirror output to the console, and keep it around for the dashboard
+		err = client.runPiped(testCmd, deadline,
+			io.MultiWriter(os.Stdout, client.commandOutput.writer(protocol.CommandOutputChunk_STDOUT)),
+			io.MultiWriter(os.Stderr, client.commandOutput.writer(protocol.CommandOutputChunk_STDERR)))
+	}
+	client.commandOutput.flush()
+	exitCode, timedOut, err := commandResult(err)
 	if err != nil {
-		return false, errors.Wrap(err, "stderr pipe")
+		return false, err
+	}
+	client.ExitCode = exitCode
+	client.commandTimedOut = timedOut
+	if timedOut {
+		client.Log("The command timed out, exit code %v", timedOutExitCode)
+		return false, nil
+	}
+	if exitCode != 0 {
+		client.Log("The command had a non-zero exit code: %v", exitCode)
+		return false, nil
+	}
+	return true, nil
+}
+
+/*
+Returns the exit code of a command which finished with the given error from
+waitForCommand, and whether it timed out. Other errors mean it couldn't be run.
+*/
+func commandResult(err error) (int, bool, error) {
+	if err == commandTimedOutError {
+		return timedOutExitCode, true, nil
 	}
-	defer stderrPipe.Close()
-	stdoutPipe, err := testCmd.StdoutPipe()
+	if exitError, ok := err.(*exec.ExitError); ok {
+		// non-0 return code
+		return exitError.ExitCode(), false, nil
+	}
+	return 0, false, err
+}
+
+func (client *Client) startAndWait(cmd *exec.Cmd, deadline time.Time) error {
+	err := client.start(cmd, deadline)
+	if err != nil {
+		return err
+	}
+	return client.waitForCommand(cmd, deadline)
+}
+
+/*
+Starts the command. If it has a deadline, it's put in its own process group,
+so that everything it started can be stopped once the deadline is reached.
+Otherwise it stays in ours, and gets Ctrl-C and the CI runner's signals directly.
+*/
+func (client *Client) start(cmd *exec.Cmd, deadline time.Time) error {
+	if !deadline.IsZero() {
+		cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	}
+	return cmd.Start()
+}
+
+/* returns whether the started command leads its own process group, e.g. in a pty */
+func ownProcessGroup(cmd *exec.Cmd) bool {
+	return cmd.SysProcAttr != nil && (cmd.SysProcAttr.Setpgid || cmd.SysProcAttr.Setsid)
+}
+
+/*
+Passes SIGINT and SIGTERM on to the process group of a command which has its
+own, since they'd otherwise only stop us and leave it running. We're then
+stopped by the signal as usual. The returned function stops forwarding.
+*/
+func forwardSignals(pid int) func() {
+	signals := make(chan os.Signal, 1)
+	stop := make(chan struct{})
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		select {
+		case sig := <-signals:
+			_ = syscall.Kill(-pid, sig.(syscall.Signal))
+			signal.Stop(signals)
+			_ = syscall.Kill(os.Getpid(), sig.(syscall.Signal))
+		case <-stop:
+		}
+	}()
+	return func() {
+		signal.Stop(signals)
+		close(stop)
+	}
+}
+
+/*
+Waits for the started command to exit. At the deadline, its process group
+is sent SIGTERM, then SIGKILL if it still hasn't exited after a grace period.
+*/
+func (client *Client) waitForCommand(cmd *exec.Cmd, deadline time.Time) error {
+	if ownProcessGroup(cmd) {
+		defer forwardSignals(cmd.Process.Pid)()
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+	var hangTimer, deadlineTimer <-chan time.Time
+	if client.HangTimeout > 0 {
+		timer := time.NewTimer(client.HangTimeout)
+		defer timer.Stop()
+		hangTimer = timer.C
+	}
+	if !deadline.IsZero() {
+		timer := time.NewTimer(time.Until(deadline))
+		defer timer.Stop()
+		deadlineTimer = timer.C
+	}
+wait:
+	for {
+		select {
+		case err := <-done:
+			return err
+		case <-hangTimer:
+			hangTimer = nil
+			go client.attachToRunningCommand(cmd.Process.Pid)
+		case <-deadlineTimer:
+			break wait
+		}
+	}
+	client.Log("Time limit reached, stopping the command...")
+	_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGTERM)
+	select {
+	case <-done:
+		return commandTimedOutError
+	case <-time.After(killGracePeriod):
+	}
+	client.Log("The command didn't stop within %v, killing it.", killGracePeriod)
+	_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	<-done
+	return commandTimedOutError
+}
+
+/*
+Runs the command with its stdout and stderr copied to the given writers
+through pipes. Processes it left running in the background may keep them
+open, so the copies are only waited for briefly once the command has exited.
+*/
+func (client *Client) runPiped(cmd *exec.Cmd, deadline time.Time, stdout io.Writer, stderr io.Writer) error {
+	stdoutReader, stdoutWriter, err := os.Pipe()
 	if err != nil {
-		return false, errors.Wrap(err, "stdout pipe")
+		return errors.Wrap(err, "stdout pipe")
 	}
-	defer stdoutPipe.Close()
-	go io.Copy(os.Stdout, stdoutPipe)
-	go io.Copy(os.Stderr, stderrPipe)
-	client.Log("Running \"%v\"", client.TestCommand)
-	err = testCmd.Run()
+	defer stdoutReader.Close()
+	stderrReader, stderrWriter, err := os.Pipe()
 	if err != nil {
-		if exitError, ok := err.(*exec.ExitError); ok {
-			// non-0 return code
-			client.ExitCode = exitError.ExitCode()
-			client.Log("The command had a non-zero exit code: %v", exitError.ExitCode())
-			return false, nil
+		stdoutWriter.Close()
+		return errors.Wrap(err, "stderr pipe")
+	}
+	defer stderrReader.Close()
+	// files are handed to the command as they are, so Wait doesn't wait for them to be drained
+	cmd.Stdout, cmd.Stderr = stdoutWriter, stderrWriter
+	err = client.start(cmd, deadline)
+	// the command has its own copies of the write ends now
+	stdoutWriter.Close()
+	stderrWriter.Close()
+	if err != nil {
+		return err
+	}
+	var copies sync.WaitGroup
+	copies.Add(2)
+	go func() {
+		io.Copy(stdout, stdoutReader)
+		copies.Done()
+	}()
+	go func() {
+		io.Copy(stderr, stderrReader)
+		copies.Done()
+	}()
+	err = client.waitForCommand(cmd, deadline)
+	copied := make(chan struct{})
+	go func() {
+		copies.Wait()
+		close(copied)
+	}()
+	select {
+	case <-copied:
+	case <-time.After(outputDrainTimeout):
+		// e.g. a server started in the background still has the pipes open
+	}
+	return err
+}
+
+/*
+Runs the command with a pty as its stdin, stdout and stderr, so that
+tools which check for a terminal keep their colors and progress output.
+*/
+func (client *Client) runInPty(cmd *exec.Cmd, deadline time.Time, output io.Writer) error {
+	// pty.Start puts the command in its own session, and so its own process group
+	ptmx, err := pty.Start(cmd)
+	if err != nil {
+		return errors.Wrap(err, "start pty")
+	}
+	defer ptmx.Close()
+	if size, err := pty.GetsizeFull(os.Stdout); err == nil {
+		_ = pty.Setsize(ptmx, size)
+	}
+	copied := make(chan struct{})
+	go func() {
+		// returns once every process holding the pty has exited
+		io.Copy(output, ptmx)
+		close(copied)
+	}()
+	err = client.waitForCommand(cmd, deadline)
+	select {
+	case <-copied:
+	case <-time.After(outputDrainTimeout):
+		// e.g. a background process still has the pty open
+	}
+	return err
+}
+
+/* returns the deadline for an attempt starting now, or zero if there's none */
+func (client *Client) attemptDeadline(commandStart time.Time) time.Time {
+	var deadline time.Time
+	if client.CommandTimeout > 0 {
+		deadline = commandStart.Add(client.CommandTimeout)
+	}
+	if client.AttemptTimeout > 0 {
+		attemptDeadline := time.Now().Add(client.AttemptTimeout)
+		if deadline.IsZero() || attemptDeadline.Before(deadline) {
+			deadline = attemptDeadline
 		}
-		return false, err
 	}
-	return true, nil
+	return deadline
 }
 
 func (client *Client) runTestCommandWithRetries() (bool, error) {
+	client.commandOutput = newCommandOutput(client.CommandOutputBufferSize, client.redactor)
 	// wrap with bash to allow for pipes and such
+	command := client.TestCommand
+	commandStart := time.Now()
 	for attempt := 0; attempt <= client.NumRetries; attempt++ {
+		if client.CommandTimeout > 0 && time.Since(commandStart) >= client.CommandTimeout {
+			client.Log("The overall time limit of %v was reached, not retrying.", client.CommandTimeout)
+			break
+		}
 		if client.NumRetries > 0 && attempt > 0 {
 			client.Log("Retrying command (%v/%v)...", attempt, client.NumRetries)
+			command = client.retryCommand()
 		}
-		succeeded, err := client.runTestCommand()
+		client.commandOutput.setAttempt(attempt + 1)
+		attemptStart := time.Now()
+		succeeded, err := client.runTestCommand(command, client.attemptDeadline(commandStart))
 		if err != nil {
 			return false, err
 		}
+		result := &attemptResult{
+			number:   attempt + 1,
+			exitCode: client.ExitCode,
+			duration: time.Since(attemptStart),
+			timedOut: client.commandTimedOut,
+		}
+		client.attempts = append(client.attempts, result)
 		if succeeded {
+			client.logAttempts()
+			if client.FailOnFlaky && commandWasFlaky(client.attempts) {
+				// report the failure the flaky attempt had
+				client.ExitCode = client.attempts[len(client.attempts)-2].exitCode
+				client.Log("Failing due to flakiness, exit code %v.", client.ExitCode)
+			}
 			return true, nil
 		}
+		var passed map[string]bool
+		client.testReport, passed = client.collectTestReport(attemptStart)
+		if client.testReport != nil {
+			result.passedTests = passed
+			for _, failure := range client.testReport.Failure {
+				result.failedTests = append(result.failedTests, testName(failure.Suite, failure.Name))
+			}
+		}
 	}
+	client.logAttempts()
+	client.logTestReport(client.testReport)
 	return false, nil
 }
 
+/*
+Connects to the wrap.sh server and opens the terminal, unless that was
+already done. Returns whether the session was started by this call.
+*/
+func (client *Client) startDebugSession() (bool, error) {
+	client.debugSessionMutex.Lock()
+	defer client.debugSessionMutex.Unlock()
+	if client.debugSessionStarted {
+		return false, nil
+	}
+	err := client.connectToServer()
+	if err != nil {
+		return false, err
+	}
+	client.debugSessionStarted = true
+	client.debugSessionStart = time.Now()
+	if client.TerminalAccess != protocol.Access_DISABLED {
+		go client.startPty()
+	}
+	go client.listenServer()
+	go client.timeout()
+	return true, nil
+}
+
+func (client *Client) isCommandFinished() bool {
+	return atomic.LoadInt32(&client.commandFinished) == 1
+}
+
+func (client *Client) hasDebugSession() bool {
+	client.debugSessionMutex.Lock()
+	defer client.debugSessionMutex.Unlock()
+	return client.debugSessionStarted
+}
+
+/* notes that a dashboard is attached, since it sent a message */
+func (client *Client) addDashboard(listenerId uint32) {
+	client.dashboardsMutex.Lock()
+	defer client.dashboardsMutex.Unlock()
+	if client.dashboards == nil {
+		client.dashboards = map[uint32]bool{}
+	}
+	client.dashboards[listenerId] = true
+}
+
+/* forgets a dashboard which detached, closing the session if it was only kept open for the dashboards */
+func (client *Client) removeDashboard(listenerId uint32) {
+	client.dashboardsMutex.Lock()
+	delete(client.dashboards, listenerId)
+	unattended := len(client.dashboards) == 0 && client.closeWhenUnattended
+	client.dashboardsMutex.Unlock()
+	if unattended {
+		client.Log("The dashboard was closed, closing the debug session.")
+		go client.close()
+	}
+}
+
+/*
+Returns false if no dashboard is attached. Otherwise the session is closed
+once the last one detaches, and this returns true.
+*/
+func (client *Client) closeOnceUnattended() bool {
+	client.dashboardsMutex.Lock()
+	defer client.dashboardsMutex.Unlock()
+	if len(client.dashboards) == 0 {
+		return false
+	}
+	client.closeWhenUnattended = true
+	return true
+}
+
+/* starts the debug session while the test command with the given pid hangs */
+func (client *Client) attachToRunningCommand(pid int) {
+	if client.hasDebugSession() {
+		return
+	}
+	atomic.StoreInt32(&client.hungPid, int32(pid))
+	client.Log("The command is still running after %v (pid %v), starting the debug session...",
+		client.HangTimeout, pid)
+	started, err := client.startDebugSession()
+	if err != nil {
+		// we'll try again once the command has exited, if it fails
+		client.Log("Could not start the debug session: %v", err)
+		return
+	}
+	if started {
+		go client.followCommandOutput(client.commandOutputSeq)
+	}
+}
+
 func (client *Client) Run() {
 	if client.TestCommand == "" {
 		client.Log("No command was specified, shutting down.")
 		return
 	}
+	client.closedChan = make(chan struct{}, 1)
+	client.wsChanged = sync.NewCond(&client.wsWriteMutex)
+	client.redactor = client.newRedactor()
 	commandSucceeded, err := client.runTestCommandWithRetries()
 	if err != nil {
 		log.Fatalf(errors.Wrap(err, "run test command").Error())
 		return
 	}
-	if commandSucceeded {
+	atomic.StoreInt32(&client.commandFinished, 1)
+	if client.isClosed() {
+		// the debug session was opened during the command, and has ended already
 		return
 	}
-	err = client.connectToServer()
-	if err != nil {
-		panic(errors.Wrap(err, "could not connect"))
+	if commandSucceeded {
+		if !client.hasDebugSession() {
+			return
+		}
+		if !client.closeOnceUnattended() {
+			client.Log("The command finished, closing the debug session.")
+			client.close()
+			return
+		}
+		// e.g. a retry passed while someone was looking into the hang
+		client.Log("The command finished, the debug session stays open until the dashboard is closed.")
+		err = client.sendAttempts()
+		if err != nil {
+			client.Log("Could not send the test results: %v", err)
+		}
+	} else {
+		started, err := client.startDebugSession()
+		if err != nil {
+			panic(errors.Wrap(err, "could not connect"))
+		}
+		if !started {
+			// the session was started while the command hung, it still needs the results
+			client.Log("The command finished, the debug session stays open.")
+			err = client.sendTestReport()
+			if err == nil {
+				err = client.sendAttempts()
+			}
+			if err != nil {
+				client.Log("Could not send the test results: %v", err)
+			}
+		}
 	}
-	go client.startPty()
-	go client.listenServer()
-	go client.timeout()
 	interrupt := make(chan os.Signal, 1)
-	client.closedChan = make(chan struct{}, 1)
 	signal.Notify(interrupt, os.Interrupt)
 	select {
 	case <-interrupt:
@@ -149,7 +656,7 @@ func (client *Client) timeout() {
 		select {
 		case <-ticker.C:
 			client.TimeoutMinutes -= 1
-			if client.wasAccessed {
+			if atomic.LoadInt32(&client.wasAccessed) == 1 {
 				client.Log("client was accessed, timeout cancelled.")
 				return
 			}
@@ -162,62 +669,192 @@ func (client *Client) timeout() {
 	}
 }
 
+/* whether close() was called, closed is set atomically since it's checked from every goroutine */
+func (client *Client) isClosed() bool {
+	return atomic.LoadInt32(&client.closed) != 0
+}
+
 func (client *Client) close() {
 	client.closingMutex.Lock()
 	defer client.closingMutex.Unlock()
-	if client.closed {
+	if client.isClosed() {
 		return
 	}
-	client.closed = true
+	atomic.StoreInt32(&client.closed, 1)
 	client.debugLog("closing...")
-	// close terminal
-	client.terminal.closer.Do(client.terminal.close)
-	client.debugLog("closed bash tty")
+	// close terminals
+	client.closeTerminals(true, 0)
+	client.waitForTerminals()
+	client.debugLog("closed bash ttys")
+	client.abortUploads()
 	// close all open connections
 	for _, rc := range client.connections {
 		rc.Close()
 	}
 	client.debugLog("closed tcp connections")
-	_ = client.ws.Close()
+	client.wsWriteMutex.Lock()
+	if client.ws != nil {
+		// let the server know this isn't a dropped connection
+		_ = client.ws.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+		_ = client.ws.Close()
+	}
+	client.wsChanged.Broadcast()
+	client.wsWriteMutex.Unlock()
 	client.debugLog("closed websocket connection")
 	// stop waiting for interrupt
 	close(client.closedChan)
 }
 
+/* waits a little for closed terminals to finish their recordings */
+func (client *Client) waitForTerminals() {
+	done := make(chan struct{})
+	go func() {
+		client.terminalsDone.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(terminalCloseTimeout):
+		client.debugLog("gave up waiting for terminals to close")
+	}
+}
+
 func (client *Client) HandleMessage(message *protocol.MessageToWrapClient) error {
 	// some messages are sent by specific listeners on the server side (e.g. file read)
 	listenerId := message.GetListenerId()
+	if terminalClose := message.GetTerminalClose(); terminalClose != nil && terminalClose.GetSessionId() == 0 {
+		// the server closes every terminal of a dashboard which detached
+		client.removeDashboard(listenerId)
+	} else if listenerId != 0 {
+		client.addDashboard(listenerId)
+	}
+	if err := client.checkAccess(message); err != nil {
+		client.sendError(err, listenerId)
+		return err
+	}
 	// TCP
 	if write := message.GetTcpWriteCall(); write != nil {
-		client.wasAccessed = true
+		atomic.StoreInt32(&client.wasAccessed, 1)
 		return client.handleTcpWriteCall(write)
 	}
 	if read := message.GetTcpReadCall(); read != nil {
-		client.wasAccessed = true
+		atomic.StoreInt32(&client.wasAccessed, 1)
 		return client.handleTcpReadCall(read)
 	}
 	if dial := message.GetTcpDialCall(); dial != nil {
-		client.wasAccessed = true
+		atomic.StoreInt32(&client.wasAccessed, 1)
 		return client.handleTcpDialCall(dial)
 	}
 	// Terminal Pty
 	if termWrite := message.GetTerminalWrite(); termWrite != nil {
-		client.wasAccessed = true
-		return client.handleTerminalWrite(termWrite)
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleTerminalWrite(termWrite, listenerId)
 	}
 	if termWidth := message.GetTerminalWidth(); termWidth != nil {
-		client.wasAccessed = true
-		return client.handleTerminalWidth(termWidth)
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleTerminalWidth(termWidth, listenerId)
+	}
+	if termResize := message.GetTerminalResize(); termResize != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleTerminalResize(termResize, listenerId)
+	}
+	if termOpen := message.GetTerminalOpen(); termOpen != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleTerminalOpen(termOpen, listenerId)
+	}
+	if termClose := message.GetTerminalClose(); termClose != nil {
+		return client.handleTerminalClose(termClose, listenerId)
+	}
+	// Reruns
+	if rerun := message.GetRerunCommand(); rerun != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleRerunCommand(rerun, listenerId)
 	}
 	// File browser
 	if fileRead := message.GetFileRead(); fileRead != nil {
-		client.wasAccessed = true
+		atomic.StoreInt32(&client.wasAccessed, 1)
 		return client.handleFileRead(fileRead, listenerId)
 	}
 	if fileReadDir := message.GetFileReadDir(); fileReadDir != nil {
-		client.wasAccessed = true
+		atomic.StoreInt32(&client.wasAccessed, 1)
 		return client.handleFileReadDir(fileReadDir, listenerId)
 	}
+	if dirArchive := message.GetDirArchive(); dirArchive != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleDirArchive(dirArchive, listenerId)
+	}
+	if fileDownload := message.GetFileDownload(); fileDownload != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleFileDownload(fileDownload, listenerId)
+	}
+	if fileChunkAck := message.GetFileChunkAck(); fileChunkAck != nil {
+		return client.handleFileChunkAck(fileChunkAck, listenerId)
+	}
+	// File search
+	if fileSearch := message.GetFileSearch(); fileSearch != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleFileSearch(fileSearch, listenerId)
+	}
+	if fileGrep := message.GetFileGrep(); fileGrep != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleFileGrep(fileGrep, listenerId)
+	}
+	if searchCancel := message.GetFileSearchCancel(); searchCancel != nil {
+		return client.handleFileSearchCancel(searchCancel, listenerId)
+	}
+	// Git
+	if gitStatus := message.GetGitStatus(); gitStatus != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleGitStatus(gitStatus, listenerId)
+	}
+	if gitDiff := message.GetGitDiff(); gitDiff != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleGitDiff(gitDiff, listenerId)
+	}
+	if gitBlame := message.GetGitBlame(); gitBlame != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleGitBlame(gitBlame, listenerId)
+	}
+	if exportPatch := message.GetExportPatch(); exportPatch != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleExportPatch(exportPatch, listenerId)
+	}
+	// File uploads
+	if uploadBegin := message.GetFileUploadBegin(); uploadBegin != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleFileUploadBegin(uploadBegin, listenerId)
+	}
+	if uploadChunk := message.GetFileUploadChunk(); uploadChunk != nil {
+		return client.handleFileUploadChunk(uploadChunk, listenerId)
+	}
+	if uploadCommit := message.GetFileUploadCommit(); uploadCommit != nil {
+		return client.handleFileUploadCommit(uploadCommit, listenerId)
+	}
+	if uploadAbort := message.GetFileUploadAbort(); uploadAbort != nil {
+		return client.handleFileUploadAbort(uploadAbort, listenerId)
+	}
+	// File editing
+	if fileWrite := message.GetFileWrite(); fileWrite != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleFileWrite(fileWrite, listenerId)
+	}
+	if fileCreate := message.GetFileCreate(); fileCreate != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleFileCreate(fileCreate, listenerId)
+	}
+	if fileDelete := message.GetFileDelete(); fileDelete != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleFileDelete(fileDelete, listenerId)
+	}
+	if fileRename := message.GetFileRename(); fileRename != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleFileRename(fileRename, listenerId)
+	}
+	if makeDir := message.GetMakeDir(); makeDir != nil {
+		atomic.StoreInt32(&client.wasAccessed, 1)
+		return client.handleMakeDir(makeDir, listenerId)
+	}
 	// response to our Hello message
 	if helloResponse := message.GetHelloResponse(); helloResponse != nil {
 		return client.handleHelloResponse(helloResponse)
@@ -0,0 +1,123 @@
+package wrap
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+/* returns whether the process is running, counting zombies as exited */
+func processRunning(pid int) bool {
+	if syscall.Kill(pid, 0) != nil {
+		return false
+	}
+	stat, err := ioutil.ReadFile("/proc/" + strconv.Itoa(pid) + "/stat")
+	if err != nil {
+		return false
+	}
+	// the state follows the command name, which is in parentheses
+	fields := strings.Fields(string(stat[strings.LastIndexByte(string(stat), ')')+1:]))
+	return len(fields) > 0 && fields[0] != "Z"
+}
+
+func TestAttemptTimeoutKillsProcessGroup(t *testing.T) {
+	defer func(gracePeriod time.Duration) {
+		killGracePeriod = gracePeriod
+	}(killGracePeriod)
+	killGracePeriod = 200 * time.Millisecond
+	dir, err := ioutil.TempDir("", "Wrap.TestAttemptTimeout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	pidFile := filepath.Join(dir, "pid")
+
+	c := newBlankTestClient()
+	c.commandOutput = newCommandOutput(0, nil)
+	// ignoring SIGTERM, which the background sleep inherits, so only SIGKILL stops them
+	command := "trap '' TERM; sleep 30 & echo $! > " + pidFile + "; wait"
+	start := time.Now()
+	succeeded, err := c.runTestCommand(command, time.Now().Add(300*time.Millisecond))
+	assertNil(t, "err", err)
+	assertEqual(t, "succeeded", false, succeeded)
+	assertEqual(t, "commandTimedOut", true, c.commandTimedOut)
+	assertEqual(t, "ExitCode", timedOutExitCode, c.ExitCode)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("Expected the command to be killed soon after the deadline, took %v", elapsed)
+	}
+
+	b, err := ioutil.ReadFile(pidFile)
+	assertNil(t, "err", err)
+	pid, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	assertNil(t, "err", err)
+	for i := 0; i < 50 && processRunning(pid); i++ {
+		time.Sleep(20 * time.Millisecond)
+	}
+	assertEqual(t, "background process running", false, processRunning(pid))
+}
+
+func TestBackgroundProcessDoesNotBlockCommand(t *testing.T) {
+	c := newBlankTestClient()
+	c.commandOutput = newCommandOutput(0, nil)
+	start := time.Now()
+	succeeded, err := c.runTestCommand("sleep 5 & echo hi", time.Time{})
+	assertNil(t, "err", err)
+	assertEqual(t, "succeeded", true, succeeded)
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Fatalf("Expected the command to return without waiting for the background process, took %v", elapsed)
+	}
+	messages := c.commandOutput.messages()
+	assertEqual(t, "message count", 1, len(messages))
+	assertEqual(t, "output", "hi\n", string(messages[0].Chunk[0].Data))
+}
+
+func TestProcessGroupOnlyForDeadlines(t *testing.T) {
+	dir, err := ioutil.TempDir("", "Wrap.TestProcessGroup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	pgidFile := filepath.Join(dir, "pgid")
+	// the process group id is the fifth field, after the state
+	command := "cut -d' ' -f5 /proc/$$/stat > " + pgidFile
+	for _, deadline := range []time.Time{{}, time.Now().Add(time.Minute)} {
+		c := newBlankTestClient()
+		c.commandOutput = newCommandOutput(0, nil)
+		succeeded, err := c.runTestCommand(command, deadline)
+		assertNil(t, "err", err)
+		assertEqual(t, "succeeded", true, succeeded)
+		b, err := ioutil.ReadFile(pgidFile)
+		assertNil(t, "err", err)
+		pgid, err := strconv.Atoi(strings.TrimSpace(string(b)))
+		assertNil(t, "err", err)
+		assertEqual(t, "own process group", !deadline.IsZero(), pgid != syscall.Getpgrp())
+	}
+}
+
+func TestHangTimeoutStartsDebugSession(t *testing.T) {
+	c := newBlankTestClient()
+	c.commandOutput = newCommandOutput(0, nil)
+	c.HangTimeout = 100 * time.Millisecond
+	// nothing listens there, so starting the session fails and the command carries on
+	c.WebsocketLocation = "ws://127.0.0.1:1/wrap"
+	succeeded, err := c.runTestCommand("sleep 0.5", time.Time{})
+	assertNil(t, "err", err)
+	assertEqual(t, "succeeded", true, succeeded)
+	assertEqual(t, "commandTimedOut", false, c.commandTimedOut)
+	if atomic.LoadInt32(&c.hungPid) == 0 {
+		t.Fatal("Expected the hang timer to try to start the debug session")
+	}
+}
+
+func TestSessionKeptForAttachedDashboards(t *testing.T) {
+	c := newBlankTestClient()
+	assertEqual(t, "kept", false, c.closeOnceUnattended())
+	c.addDashboard(1)
+	assertEqual(t, "kept", true, c.closeOnceUnattended())
+}
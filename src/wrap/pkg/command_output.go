@@ -0,0 +1,214 @@
+package wrap
+
+import (
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"io"
+	"sync"
+	"time"
+)
+
+const defaultCommandOutputBufferSize = 1024 * 1024
+
+// command output is split into messages of roughly this size
+const maxCommandOutputMessageSize = 64 * 1024
+
+/*
+Keeps the most recent output of the test command, across all attempts,
+so that it can be shown on the dashboard once the debug session starts.
+*/
+type commandOutput struct {
+	mutex     sync.Mutex
+	maxSize   int
+	size      int
+	attempt   uint32
+	chunks    []*protocol.CommandOutputChunk
+	truncated bool
+	// sequence number of chunks[0], counting every chunk ever written
+	firstSeq uint64
+	// signalled whenever output is written
+	updated chan struct{}
+	// secrets are masked before output is kept, per stream. Output held back
+	// for redaction is flushed after a moment, so a stalled command's last line shows
+	redactor   *redactor
+	redactions map[protocol.CommandOutputChunk_Stream]*timedRedactingStream
+}
+
+func newCommandOutput(maxSize int, r *redactor) *commandOutput {
+	if maxSize <= 0 {
+		maxSize = defaultCommandOutputBufferSize
+	}
+	return &commandOutput{
+		maxSize:    maxSize,
+		updated:    make(chan struct{}, 1),
+		redactor:   r,
+		redactions: map[protocol.CommandOutputChunk_Stream]*timedRedactingStream{},
+	}
+}
+
+func (o *commandOutput) setAttempt(attempt int) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.attempt = uint32(attempt)
+}
+
+func (o *commandOutput) write(stream protocol.CommandOutputChunk_Stream, b []byte) {
+	o.mutex.Lock()
+	redaction, ok := o.redactions[stream]
+	if !ok {
+		redaction = newTimedRedactingStream(o.redactor, func(b []byte) {
+			// called with the stream's mutex held, which is always taken before o.mutex
+			o.mutex.Lock()
+			defer o.mutex.Unlock()
+			o.add(stream, b)
+		})
+		o.redactions[stream] = redaction
+	}
+	o.mutex.Unlock()
+	redaction.write(b)
+}
+
+/* keeps the output which was held back for redaction, e.g. once the command has exited */
+func (o *commandOutput) flush() {
+	o.mutex.Lock()
+	var redactions []*timedRedactingStream
+	for _, redaction := range o.redactions {
+		redactions = append(redactions, redaction)
+	}
+	o.mutex.Unlock()
+	for _, redaction := range redactions {
+		redaction.flushPending()
+	}
+}
+
+/* keeps a chunk of output, the caller must hold the mutex */
+func (o *commandOutput) add(stream protocol.CommandOutputChunk_Stream, b []byte) {
+	if len(b) == 0 {
+		return
+	}
+	data := make([]byte, len(b))
+	copy(data, b)
+	o.chunks = append(o.chunks, &protocol.CommandOutputChunk{
+		Attempt:   o.attempt,
+		Stream:    stream,
+		Timestamp: time.Now().UnixNano() / int64(time.Millisecond),
+		Data:      data,
+	})
+	o.size += len(data)
+	// drop the oldest output until we're back within bounds
+	for o.size > o.maxSize {
+		o.truncated = true
+		oldest := o.chunks[0]
+		overflow := o.size - o.maxSize
+		if overflow < len(oldest.Data) {
+			oldest.Data = oldest.Data[overflow:]
+			o.size -= overflow
+			break
+		}
+		o.size -= len(oldest.Data)
+		o.chunks[0] = nil
+		o.chunks = o.chunks[1:]
+		o.firstSeq++
+	}
+	select {
+	case o.updated <- struct{}{}:
+	default:
+	}
+}
+
+/* returns a writer which records everything written to it as output of the given stream */
+func (o *commandOutput) writer(stream protocol.CommandOutputChunk_Stream) io.Writer {
+	return &commandOutputWriter{output: o, stream: stream}
+}
+
+/* splits the captured output into messages for the wrap.sh server */
+func (o *commandOutput) messages() []*protocol.CommandOutput {
+	result, _ := o.messagesSince(0)
+	return result
+}
+
+/*
+Splits the output written since the given sequence number into messages
+for the wrap.sh server, also returning the sequence number to continue from.
+*/
+func (o *commandOutput) messagesSince(seq uint64) ([]*protocol.CommandOutput, uint64) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	var result []*protocol.CommandOutput
+	truncated := o.truncated
+	start := 0
+	if seq > o.firstSeq {
+		// the dashboard already has everything before seq
+		start = int(seq - o.firstSeq)
+		truncated = false
+	} else if seq > 0 && seq < o.firstSeq {
+		truncated = true
+	}
+	msg := &protocol.CommandOutput{Truncated: truncated}
+	size := 0
+	for _, chunk := range o.chunks[start:] {
+		if size+len(chunk.Data) > maxCommandOutputMessageSize && len(msg.Chunk) > 0 {
+			result = append(result, msg)
+			msg = &protocol.CommandOutput{Truncated: truncated}
+			size = 0
+		}
+		// copied, since write() may trim the oldest chunk while this is being sent
+		msg.Chunk = append(msg.Chunk, &protocol.CommandOutputChunk{
+			Attempt:   chunk.Attempt,
+			Stream:    chunk.Stream,
+			Timestamp: chunk.Timestamp,
+			Data:      chunk.Data,
+		})
+		size += len(chunk.Data)
+	}
+	if len(msg.Chunk) > 0 {
+		result = append(result, msg)
+	}
+	return result, o.firstSeq + uint64(len(o.chunks))
+}
+
+type commandOutputWriter struct {
+	output *commandOutput
+	stream protocol.CommandOutputChunk_Stream
+}
+
+func (w *commandOutputWriter) Write(b []byte) (int, error) {
+	w.output.write(w.stream, b)
+	return len(b), nil
+}
+
+/* sends the command output written since seq, returning the sequence number to continue from */
+func (client *Client) sendCommandOutput(seq uint64) (uint64, error) {
+	if client.commandOutput == nil {
+		return 0, nil
+	}
+	messages, next := client.commandOutput.messagesSince(seq)
+	for _, msg := range messages {
+		err := client.send(&protocol.MessageFromWrapClient{
+			Spec: &protocol.MessageFromWrapClient_CommandOutput{
+				CommandOutput: msg,
+			},
+		})
+		if err != nil {
+			return seq, errors.Wrap(err, "send command output")
+		}
+	}
+	return next, nil
+}
+
+/* keeps sending the command's output as it's written, while the debug session is open */
+func (client *Client) followCommandOutput(seq uint64) {
+	for {
+		select {
+		case <-client.commandOutput.updated:
+		case <-client.closedChan:
+			return
+		}
+		var err error
+		seq, err = client.sendCommandOutput(seq)
+		if err != nil {
+			client.debugLog(err.Error())
+			return
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package wrap
+
+import (
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"testing"
+	"time"
+)
+
+func TestCommandOutputDropsOldest(t *testing.T) {
+	o := newCommandOutput(8, nil)
+	o.writer(protocol.CommandOutputChunk_STDOUT).Write([]byte("abcd"))
+	o.setAttempt(1)
+	o.writer(protocol.CommandOutputChunk_STDERR).Write([]byte("efghij"))
+	messages := o.messages()
+	assertEqual(t, "message count", 1, len(messages))
+	assertEqual(t, "truncated", true, messages[0].Truncated)
+	chunks := messages[0].Chunk
+	assertEqual(t, "chunk count", 2, len(chunks))
+	assertEqual(t, "first chunk", "cd", string(chunks[0].Data))
+	assertEqual(t, "first chunk stream", protocol.CommandOutputChunk_STDOUT, chunks[0].Stream)
+	assertEqual(t, "second chunk", "efghij", string(chunks[1].Data))
+	assertEqual(t, "second chunk attempt", uint32(1), chunks[1].Attempt)
+}
+
+func TestCommandOutputSplitsMessages(t *testing.T) {
+	o := newCommandOutput(4*maxCommandOutputMessageSize, nil)
+	w := o.writer(protocol.CommandOutputChunk_STDOUT)
+	for i := 0; i < 3; i++ {
+		w.Write(make([]byte, maxCommandOutputMessageSize))
+	}
+	assertEqual(t, "message count", 3, len(o.messages()))
+}
+
+func TestCommandOutputMessagesSince(t *testing.T) {
+	o := newCommandOutput(1024, nil)
+	w := o.writer(protocol.CommandOutputChunk_STDOUT)
+	w.Write([]byte("first"))
+	messages, seq := o.messagesSince(0)
+	assertEqual(t, "message count", 1, len(messages))
+	assertEqual(t, "next seq", uint64(1), seq)
+	w.Write([]byte("second"))
+	messages, seq = o.messagesSince(seq)
+	assertEqual(t, "message count", 1, len(messages))
+	assertEqual(t, "chunk", "second", string(messages[0].Chunk[0].Data))
+	assertEqual(t, "next seq", uint64(2), seq)
+	messages, _ = o.messagesSince(seq)
+	assertEqual(t, "message count", 0, len(messages))
+}
+
+func TestCommandOutputFlushesHeldBackOutput(t *testing.T) {
+	o := newCommandOutput(1024, newRedactor([]string{"hunter2secret"}, nil))
+	// e.g. the prompt of a command waiting for input, held back in case it's the start of a secret
+	o.writer(protocol.CommandOutputChunk_STDOUT).Write([]byte("Continue? "))
+	for i := 0; i < 50 && len(o.messages()) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	messages := o.messages()
+	assertEqual(t, "message count", 1, len(messages))
+	assertEqual(t, "chunk", "Continue? ", string(messages[0].Chunk[0].Data))
+}
@@ -0,0 +1,507 @@
+package wrap
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// archives are limited to this many bytes before compression, unless the request sets a limit
+const defaultMaxArchiveSize = 1024 * 1024 * 1024
+
+const archiveProgressInterval = 500 * time.Millisecond
+
+// files up to this size are redacted in memory, bigger ones through a temporary file
+const maxRedactedInMemory = 4 * 1024 * 1024
+
+var archiveTooBigError = errors.New("archive too big")
+
+/* the entries of a tar.gz or zip archive */
+type archiveWriter interface {
+	addDir(name string, info os.FileInfo) error
+	addSymlink(name string, target string, info os.FileInfo) error
+	addFile(name string, info os.FileInfo, size int64, content io.Reader) error
+	Close() error
+}
+
+type tarGzWriter struct {
+	gz  *gzip.Writer
+	tar *tar.Writer
+}
+
+func newTarGzWriter(w io.Writer) *tarGzWriter {
+	gz := gzip.NewWriter(w)
+	return &tarGzWriter{gz: gz, tar: tar.NewWriter(gz)}
+}
+
+func (w *tarGzWriter) add(name string, info os.FileInfo, link string, size int64, content io.Reader) error {
+	header, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	header.Size = size
+	err = w.tar.WriteHeader(header)
+	if err != nil || content == nil {
+		return err
+	}
+	_, err = io.CopyN(w.tar, content, size)
+	return err
+}
+
+func (w *tarGzWriter) addDir(name string, info os.FileInfo) error {
+	return w.add(name+"/", info, "", 0, nil)
+}
+
+func (w *tarGzWriter) addSymlink(name string, target string, info os.FileInfo) error {
+	return w.add(name, info, target, 0, nil)
+}
+
+func (w *tarGzWriter) addFile(name string, info os.FileInfo, size int64, content io.Reader) error {
+	return w.add(name, info, "", size, content)
+}
+
+func (w *tarGzWriter) Close() error {
+	err := w.tar.Close()
+	if err != nil {
+		return err
+	}
+	return w.gz.Close()
+}
+
+type zipArchiveWriter struct {
+	zip *zip.Writer
+}
+
+func (w *zipArchiveWriter) add(name string, info os.FileInfo, content io.Reader) error {
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	if !info.IsDir() {
+		header.Method = zip.Deflate
+	}
+	entry, err := w.zip.CreateHeader(header)
+	if err != nil || content == nil {
+		return err
+	}
+	_, err = io.Copy(entry, content)
+	return err
+}
+
+func (w *zipArchiveWriter) addDir(name string, info os.FileInfo) error {
+	return w.add(name+"/", info, nil)
+}
+
+func (w *zipArchiveWriter) addSymlink(name string, target string, info os.FileInfo) error {
+	// zip stores a link's target as its contents
+	return w.add(name, info, strings.NewReader(target))
+}
+
+func (w *zipArchiveWriter) addFile(name string, info os.FileInfo, size int64, content io.Reader) error {
+	return w.add(name, info, io.LimitReader(content, size))
+}
+
+func (w *zipArchiveWriter) Close() error {
+	return w.zip.Close()
+}
+
+/*
+Compiles globs to match relative paths, or names for globs without a slash,
+e.g. the includes and excludes of an archive.
+*/
+func compileGlobs(globs []string) ([]*regexp.Regexp, error) {
+	var compiled []*regexp.Regexp
+	for _, glob := range globs {
+		glob = strings.Trim(glob, "/")
+		if !strings.Contains(glob, "/") {
+			glob = "**/" + glob
+		}
+		re, err := globRegexp(glob)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid glob %q", glob)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+func matchesAnyGlob(globs []*regexp.Regexp, rel string) bool {
+	for _, glob := range globs {
+		if glob.MatchString(rel) {
+			return true
+		}
+	}
+	return false
+}
+
+/* walks a directory, adding what the request asks for to an archive */
+type archiver struct {
+	client   *Client
+	msg      *protocol.DirArchive
+	include  []*regexp.Regexp
+	exclude  []*regexp.Regexp
+	writer   archiveWriter
+	root     string
+	maxSize  uint64
+	redacted bool
+	// real paths of the directories being walked, to stop at symlink loops
+	walking      map[string]bool
+	progress     protocol.DirArchiveProgress
+	lastProgress time.Time
+	onProgress   func(*protocol.DirArchiveProgress)
+}
+
+func (client *Client) newArchiver(msg *protocol.DirArchive, writer archiveWriter) *archiver {
+	a := &archiver{
+		client:  client,
+		msg:     msg,
+		writer:  writer,
+		root:    filepath.Base(filepath.Clean(msg.GetPath())),
+		maxSize: msg.GetMaxSize(),
+		walking: map[string]bool{},
+		progress: protocol.DirArchiveProgress{
+			DownloadId: msg.GetDownloadId(),
+		},
+		onProgress: func(*protocol.DirArchiveProgress) {},
+	}
+	if a.root == "/" || a.root == "." {
+		a.root = "archive"
+	}
+	if a.maxSize == 0 {
+		a.maxSize = defaultMaxArchiveSize
+	}
+	return a
+}
+
+/* the entry name of a path relative to the archived directory */
+func (a *archiver) name(rel string) string {
+	return path.Join(a.root, rel)
+}
+
+/* whether a file is included, either itself or by a directory it's in */
+func (a *archiver) included(rel string) bool {
+	if len(a.include) == 0 {
+		return true
+	}
+	for p := rel; p != "." && p != "/"; p = path.Dir(p) {
+		if matchesAnyGlob(a.include, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *archiver) skip(p string, err error) {
+	a.client.debugLog("archive: skipping %v: %v", p, err)
+	a.progress.Skipped++
+}
+
+func (a *archiver) sendProgress(done bool) {
+	if !done && time.Since(a.lastProgress) < archiveProgressInterval {
+		return
+	}
+	a.lastProgress = time.Now()
+	a.progress.Done = done
+	a.onProgress(&a.progress)
+}
+
+/* archives the requested directory, then finishes the archive */
+func (a *archiver) archive() error {
+	var err error
+	a.include, err = compileGlobs(a.msg.GetInclude())
+	if err != nil {
+		return err
+	}
+	a.exclude, err = compileGlobs(a.msg.GetExclude())
+	if err != nil {
+		return err
+	}
+	dir := a.msg.GetPath()
+	real, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+	a.walking[real] = true
+	err = a.walk(dir, "")
+	if err != nil {
+		return err
+	}
+	return a.writer.Close()
+}
+
+func (a *archiver) walk(dir string, rel string) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		a.skip(dir, err)
+		return nil
+	}
+	names, err := f.Readdirnames(-1)
+	_ = f.Close()
+	if err != nil {
+		a.skip(dir, err)
+		return nil
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p := filepath.Join(dir, name)
+		r := path.Join(rel, name)
+		if matchesAnyGlob(a.exclude, r) {
+			continue
+		}
+		info, err := os.Lstat(p)
+		if err != nil {
+			a.skip(p, err)
+			continue
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			switch a.msg.GetSymlinks() {
+			case protocol.DirArchive_SKIP:
+				continue
+			case protocol.DirArchive_PRESERVE:
+				if !a.included(r) {
+					continue
+				}
+				target, err := os.Readlink(p)
+				if err != nil {
+					a.skip(p, err)
+					continue
+				}
+				err = a.writer.addSymlink(a.name(r), target, info)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			info, err = os.Stat(p)
+			if err != nil {
+				// e.g. a broken link
+				a.skip(p, err)
+				continue
+			}
+		}
+		switch {
+		case info.IsDir():
+			real, err := filepath.EvalSymlinks(p)
+			if err != nil {
+				a.skip(p, err)
+				continue
+			}
+			if a.walking[real] {
+				a.skip(p, errors.New("symlink loop"))
+				continue
+			}
+			if len(a.msg.GetInclude()) == 0 {
+				err = a.writer.addDir(a.name(r), info)
+				if err != nil {
+					return err
+				}
+			}
+			a.walking[real] = true
+			err = a.walk(p, r)
+			delete(a.walking, real)
+			if err != nil {
+				return err
+			}
+		case info.Mode().IsRegular():
+			if !a.included(r) {
+				continue
+			}
+			err = a.addFile(p, r, info)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (a *archiver) addFile(p string, rel string, info os.FileInfo) error {
+	// the limit applies to what's written, which redaction can make bigger or smaller
+	remaining := a.maxSize - a.progress.Bytes
+	f, err := os.Open(p)
+	if err != nil {
+		a.skip(p, err)
+		return nil
+	}
+	defer f.Close()
+	var content io.Reader = f
+	size := info.Size()
+	if a.client.redactor != nil {
+		var cleanup func()
+		content, size, cleanup, err = a.redact(f, size, remaining)
+		if err == archiveTooBigError {
+			return err
+		}
+		if err != nil {
+			a.skip(p, err)
+			return nil
+		}
+		defer cleanup()
+	}
+	if uint64(size) > remaining {
+		return archiveTooBigError
+	}
+	a.progress.CurrentPath = rel
+	a.sendProgress(false)
+	written := &countingReader{reader: content}
+	err = a.writer.addFile(a.name(rel), info, size, written)
+	if err != nil {
+		return errors.Wrapf(err, "archive %v", p)
+	}
+	a.progress.Files++
+	a.progress.Bytes += written.count
+	return nil
+}
+
+/* counts the bytes read through it */
+type countingReader struct {
+	reader io.Reader
+	count  uint64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += uint64(n)
+	return n, err
+}
+
+/*
+Masks secrets in a file's contents, returning them and their size. The
+returned function removes the temporary file used for big files. Big files
+are given up on as soon as what's masked is over the limit.
+*/
+func (a *archiver) redact(f *os.File, size int64, limit uint64) (io.Reader, int64, func(), error) {
+	stream := a.client.redactor.stream()
+	defer func() {
+		if stream.masked > 0 {
+			a.redacted = true
+		}
+	}()
+	if size <= maxRedactedInMemory {
+		b, err := ioutil.ReadAll(f)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		out := append(stream.write(b), stream.flush()...)
+		return bytes.NewReader(out), int64(len(out)), func() {}, nil
+	}
+	tmp, err := ioutil.TempFile("", "wrap-archive")
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	cleanup := func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}
+	buf := make([]byte, fileChunkSize)
+	written := uint64(0)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			out := stream.write(buf[:n])
+			written += uint64(len(out))
+			if written > limit {
+				cleanup()
+				return nil, 0, nil, archiveTooBigError
+			}
+			if _, writeErr := tmp.Write(out); writeErr != nil {
+				cleanup()
+				return nil, 0, nil, writeErr
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			cleanup()
+			return nil, 0, nil, err
+		}
+	}
+	_, err = tmp.Write(stream.flush())
+	if err == nil {
+		size, err = tmp.Seek(0, io.SeekCurrent)
+	}
+	if err == nil {
+		_, err = tmp.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		cleanup()
+		return nil, 0, nil, err
+	}
+	return tmp, size, cleanup, nil
+}
+
+/*
+Streams an archive of a directory to the listener which requested it, in
+FileChunk messages like a download, with DirArchiveProgress messages
+along the way.
+*/
+func (client *Client) handleDirArchive(msg *protocol.DirArchive, listenerId uint32) error {
+	info, err := os.Stat(msg.GetPath())
+	if err == nil && !info.IsDir() {
+		err = errors.Errorf("%v is not a directory", msg.GetPath())
+	}
+	if err != nil {
+		err = client.sendFileChunk(&protocol.FileChunk{
+			DownloadId: msg.GetDownloadId(),
+			Done:       true,
+			Error:      err.Error(),
+		}, listenerId)
+		return errors.Wrap(err, "send file chunk")
+	}
+	d, err := client.addDownload(downloadKey{listenerId: listenerId, downloadId: msg.GetDownloadId()}, msg.GetWindow())
+	if err != nil {
+		return err
+	}
+	go client.archiveDir(msg, d)
+	return nil
+}
+
+func (client *Client) archiveDir(msg *protocol.DirArchive, d *download) {
+	defer client.removeDownload(d)
+	w := client.newChunkWriter(d)
+	var writer archiveWriter
+	if msg.GetFormat() == protocol.DirArchive_ZIP {
+		writer = &zipArchiveWriter{zip: zip.NewWriter(w)}
+	} else {
+		writer = newTarGzWriter(w)
+	}
+	a := client.newArchiver(msg, writer)
+	a.onProgress = func(progress *protocol.DirArchiveProgress) {
+		err := client.send(&protocol.MessageFromWrapClient{
+			Spec: &protocol.MessageFromWrapClient_DirArchiveProgress{
+				DirArchiveProgress: progress,
+			},
+			ListenerId: d.key.listenerId,
+		})
+		if err != nil {
+			client.debugLog(errors.Wrap(err, "send archive progress").Error())
+		}
+	}
+	client.Log("Archiving %v for the dashboard", msg.GetPath())
+	err := a.archive()
+	if errors.Cause(err) == downloadCancelledError {
+		client.debugLog("archive of %v was cancelled", msg.GetPath())
+		return
+	}
+	a.sendProgress(true)
+	w.redacted = a.redacted
+	err = w.close(err)
+	if err != nil {
+		client.debugLog("archive of %v: %v", msg.GetPath(), err)
+	}
+}
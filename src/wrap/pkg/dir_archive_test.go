@@ -0,0 +1,69 @@
+package wrap
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDirArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "Wrap.TestDirArchive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	root := filepath.Join(dir, "results")
+	for name, content := range map[string]string{
+		"junit.xml":               "<testsuite/>",
+		"logs/test.log":           "token=hunter22",
+		"node_modules/x/index.js": "",
+	} {
+		p := filepath.Join(root, name)
+		os.MkdirAll(filepath.Dir(p), 0755)
+		ioutil.WriteFile(p, []byte(content), 0644)
+	}
+	os.Symlink(root, filepath.Join(root, "logs", "loop"))
+
+	c := newBlankTestClient()
+	c.redactor = newRedactor([]string{"hunter22"}, nil)
+	var buf bytes.Buffer
+	a := c.newArchiver(&protocol.DirArchive{
+		Path:     root,
+		Exclude:  []string{"node_modules"},
+		Symlinks: protocol.DirArchive_FOLLOW,
+	}, newTarGzWriter(&buf))
+	assertNil(t, "archive error", a.archive())
+	assertEqual(t, "redacted", true, a.redacted)
+	assertEqual(t, "skipped", uint64(1), a.progress.Skipped)
+
+	gz, err := gzip.NewReader(&buf)
+	assertNil(t, "gzip error", err)
+	r := tar.NewReader(gz)
+	var names []string
+	for {
+		header, err := r.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, header.Name)
+		if header.Name == "results/logs/test.log" {
+			content, _ := ioutil.ReadAll(r)
+			assertEqual(t, "redacted content", "token=[REDACTED]", string(content))
+		}
+	}
+	assertEqual(t, "names", "results/junit.xml,results/logs/,results/logs/test.log", strings.Join(names, ","))
+
+	// 12 bytes of junit.xml, and the log file's 14, which are 16 once the secret is masked
+	assertEqual(t, "bytes", uint64(28), a.progress.Bytes)
+
+	a = c.newArchiver(&protocol.DirArchive{Path: root, MaxSize: 4}, newTarGzWriter(&buf))
+	assertEqual(t, "too big error", archiveTooBigError, a.archive())
+	a = c.newArchiver(&protocol.DirArchive{Path: root, Exclude: []string{"node_modules"}, MaxSize: 26}, newTarGzWriter(&buf))
+	assertEqual(t, "too big once redacted error", archiveTooBigError, a.archive())
+}
@@ -1,59 +1,142 @@
 package wrap
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"github.com/layer-devops/wrap.sh/src/protocol"
 	"github.com/pkg/errors"
+	"io"
 	"io/ioutil"
 	"os"
-	"os/exec"
+	"os/user"
 	"path/filepath"
-	"strings"
+	"sort"
+	"strconv"
+	"syscall"
 )
 
 const maxFileReadSize = 50 * 1024 * 1024
 
 var fileTooBigError = errors.New("file too big")
 
+func fileHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+/*
+Reads a whole file, or part of it if the request has an offset or length.
+Parts are capped at the size limit, whole files bigger than it can't be read.
+*/
 func (client *Client) readFile(msg *protocol.FileRead, maxFileSize int64) (*protocol.FileReadResult, error) {
 	info, err := os.Stat(msg.GetPath())
 	if err != nil {
 		return nil, errors.Wrap(err, "stat")
 	}
-	if info.Size() > maxFileSize {
+	ranged := msg.GetOffset() != 0 || msg.GetLength() != 0 || msg.GetFromEnd()
+	if !ranged && info.Size() > maxFileSize {
 		return nil, fileTooBigError
 	}
-	content, err := ioutil.ReadFile(msg.GetPath())
+	var content, data []byte
+	var offset int64
+	if ranged {
+		// secrets can start before the part or end after it, so it's read with a
+		// margin around it to find them, and those parts of them are masked too
+		var buf []byte
+		var part span
+		buf, part, offset, err = readFileRange(msg, info.Size(), maxFileSize, client.redactor.margin())
+		if err == nil {
+			content = buf[part.start:part.end]
+			data = client.redactor.redactPart(buf, part)
+		}
+	} else {
+		content, err = ioutil.ReadFile(msg.GetPath())
+		data = client.redactor.redact(content)
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "file read")
 	}
-	cmd := exec.Command("file", "-i", msg.GetPath())
-	mimeType := ""
-	output, err := cmd.CombinedOutput()
-	if err == nil {
-		mimeType = strings.TrimSpace(strings.TrimPrefix(string(output), msg.GetPath()+":"))
+	var mimeType string
+	if ranged {
+		mimeType = detectMimeType(msg.GetPath(), info)
+	} else if len(content) > mimeSniffLength {
+		mimeType = sniffMimeType(msg.GetPath(), content[:mimeSniffLength], false)
+	} else {
+		mimeType = sniffMimeType(msg.GetPath(), content, true)
 	}
-	return &protocol.FileReadResult{
-		Data:     content,
+	result := &protocol.FileReadResult{
+		Data:     data,
 		Path:     msg.GetPath(),
 		MimeType: mimeType,
-	}, nil
+		ModTime:  info.ModTime().UnixNano(),
+		Redacted: !bytes.Equal(data, content),
+		Offset:   uint64(offset),
+		Size:     uint64(info.Size()),
+	}
+	if !ranged {
+		// conflicts are only detected for whole files, hashing a part doesn't help.
+		// Hashes are of the data sent, so that secrets can't be confirmed by guessing
+		result.Sha256 = fileHash(data)
+	}
+	return result, nil
+}
+
+/*
+Reads the part of a file of the given size which the request asks for, along
+with up to margin bytes on either side. Returns what was read, the span of it
+which was asked for, and where that starts in the file.
+*/
+func readFileRange(msg *protocol.FileRead, size int64, maxLength int64, margin int) ([]byte, span, int64, error) {
+	start := size
+	if msg.GetOffset() < uint64(size) {
+		start = int64(msg.GetOffset())
+	}
+	if msg.GetFromEnd() {
+		start = size - start
+	}
+	length := maxLength
+	if msg.GetLength() != 0 && msg.GetLength() < uint64(maxLength) {
+		length = int64(msg.GetLength())
+	}
+	if length > size-start {
+		length = size - start
+	}
+	from, to := start-int64(margin), start+length+int64(margin)
+	if from < 0 {
+		from = 0
+	}
+	if to > size {
+		to = size
+	}
+	f, err := os.Open(msg.GetPath())
+	if err != nil {
+		return nil, span{}, 0, err
+	}
+	defer f.Close()
+	content := make([]byte, to-from)
+	n, err := f.ReadAt(content, from)
+	if err != nil && err != io.EOF {
+		return nil, span{}, 0, err
+	}
+	// the file may have shrunk since it was stat'd
+	part := span{int(start - from), int(start - from + length)}
+	if part.end > n {
+		part.end = n
+	}
+	if part.start > part.end {
+		part.start = part.end
+	}
+	return content[:n], part, start, nil
 }
 
 func (client *Client) handleFileRead(msg *protocol.FileRead, listenerId uint32) error {
 	fileReadResult, err := client.readFile(msg, maxFileReadSize)
 	if err != nil {
-		err = client.send(&protocol.MessageFromWrapClient{
-			Spec: &protocol.MessageFromWrapClient_FileReadResult{
-				FileReadResult: &protocol.FileReadResult{
-					Error: err.Error(),
-				},
-			},
-			ListenerId: listenerId,
-		})
-		if err != nil {
-			panic(errors.Wrap(err, "send file-read result"))
+		fileReadResult = &protocol.FileReadResult{
+			Error: err.Error(),
 		}
-		return nil
 	}
 	err = client.send(&protocol.MessageFromWrapClient{
 		Spec: &protocol.MessageFromWrapClient_FileReadResult{
@@ -62,75 +145,395 @@ func (client *Client) handleFileRead(msg *protocol.FileRead, listenerId uint32)
 		ListenerId: listenerId,
 	})
 	if err != nil {
-		panic(errors.Wrap(err, "send file-read result"))
+		client.debugLog(errors.Wrap(err, "send file-read result").Error())
 	}
 	return nil
 }
 
+/* looks up user and group names, remembering them for the rest of a directory listing */
+type ownerNames struct {
+	users  map[uint32]string
+	groups map[uint32]string
+}
+
+func (o *ownerNames) lookup(info os.FileInfo) (string, string) {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return "", ""
+	}
+	if o.users == nil {
+		o.users, o.groups = map[uint32]string{}, map[uint32]string{}
+	}
+	owner, ok := o.users[stat.Uid]
+	if !ok {
+		owner = strconv.Itoa(int(stat.Uid))
+		if u, err := user.LookupId(owner); err == nil {
+			owner = u.Username
+		}
+		o.users[stat.Uid] = owner
+	}
+	group, ok := o.groups[stat.Gid]
+	if !ok {
+		group = strconv.Itoa(int(stat.Gid))
+		if g, err := user.LookupGroupId(group); err == nil {
+			group = g.Name
+		}
+		o.groups[stat.Gid] = group
+	}
+	return owner, group
+}
+
+/* returns whether a directory has no entries, or false if it can't be read */
+func isEmptyDir(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	_, err = f.Readdirnames(1)
+	return err == io.EOF
+}
+
+func (client *Client) dirEntry(path string, info os.FileInfo, owners *ownerNames) *protocol.DirEntry {
+	entry := &protocol.DirEntry{
+		Name:     info.Name(),
+		IsDir:    info.IsDir(),
+		IsEmpty:  true,
+		Path:     path,
+		MimeType: detectMimeType(path, info),
+		Size:     uint64(info.Size()),
+		Mode:     info.Mode().String(),
+		ModTime:  info.ModTime().UnixNano(),
+		Readable: syscall.Access(path, 4 /* R_OK */) == nil,
+	}
+	entry.Owner, entry.Group = owners.lookup(info)
+	if info.Mode()&os.ModeSymlink != 0 {
+		entry.SymlinkTarget, _ = os.Readlink(path)
+	}
+	if info.IsDir() {
+		entry.IsEmpty = isEmptyDir(path)
+	}
+	return entry
+}
+
+/* lists a directory's entries, sorted by name */
 func (client *Client) readFileDir(msg *protocol.FileReadDir) (*protocol.FileReadDirResult, error) {
 	result := &protocol.FileReadDirResult{
 		Entry: []*protocol.DirEntry{},
 		Path:  msg.GetPath(),
 	}
-	err := filepath.Walk(msg.GetPath(), func(path string, info os.FileInfo, err error) error {
-		if path == msg.GetPath() {
-			return nil
-		}
-		entry := &protocol.DirEntry{
-			Name:    info.Name(),
-			IsDir:   info.IsDir(),
-			IsEmpty: true,
-			Path:    path,
-		}
-		cmd := exec.Command("file", "-i", path)
-		output, err := cmd.CombinedOutput()
-		if err == nil {
-			entry.MimeType = strings.TrimSpace(strings.TrimPrefix(string(output), path+":"))
-		} else {
-			// might be best to fail silently
-		}
-		result.Entry = append(result.Entry, entry)
-		if info.IsDir() {
-			//TODO: HACK
-			lsCmd := exec.Command("ls", "-al", path)
-			output, err := lsCmd.CombinedOutput()
-			if err == nil {
-				entry.IsEmpty = len(strings.Split(string(output), "\n")) < 5
-			} else {
-				// e.g. permission error
-				// might be best to fail silently
-			}
-			return filepath.SkipDir
-		}
-		return nil
+	f, err := os.Open(msg.GetPath())
+	if err != nil {
+		return result, errors.Wrap(err, "open")
+	}
+	infos, err := f.Readdir(-1)
+	_ = f.Close()
+	if err != nil {
+		return result, errors.Wrap(err, "read directory")
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name() < infos[j].Name()
 	})
-	return result, err
+	owners := &ownerNames{}
+	for _, info := range infos {
+		path := filepath.Join(msg.GetPath(), info.Name())
+		result.Entry = append(result.Entry, client.dirEntry(path, info, owners))
+	}
+	addGitStatus(msg.GetPath(), result.Entry)
+	return result, nil
 }
 
 func (client *Client) handleFileReadDir(msg *protocol.FileReadDir, listenerId uint32) error {
-	fileReadDirResult, err := client.readFileDir(msg)
-	if err != nil {
+	// the git status can take a while in big checkouts, other messages shouldn't wait for it
+	go func() {
+		fileReadDirResult, err := client.readFileDir(msg)
+		if err != nil {
+			fileReadDirResult = &protocol.FileReadDirResult{Error: err.Error()}
+		}
 		err = client.send(&protocol.MessageFromWrapClient{
 			Spec: &protocol.MessageFromWrapClient_FileReadDirResult{
-				FileReadDirResult: &protocol.FileReadDirResult{
-					Error: err.Error(),
-				},
+				FileReadDirResult: fileReadDirResult,
 			},
 			ListenerId: listenerId,
 		})
 		if err != nil {
-			panic(errors.Wrap(err, "send file-read-dir result"))
+			client.debugLog(errors.Wrap(err, "send file-read-dir result").Error())
 		}
+	}()
+	return nil
+}
+
+// permissions of files and directories made from the dashboard, unless the request sets them
+const defaultFileMode = 0644
+const defaultDirMode = 0755
+
+/* an error for a file edit, with the code reported to the dashboard */
+type fileEditError struct {
+	code    protocol.FileError_Code
+	message string
+}
+
+func (e *fileEditError) Error() string {
+	return e.message
+}
+
+func newFileEditError(code protocol.FileError_Code, format string, args ...interface{}) error {
+	return &fileEditError{code: code, message: fmt.Sprintf(format, args...)}
+}
+
+/* converts an error from a file edit into the structured error sent to the dashboard */
+func fileError(err error) *protocol.FileError {
+	if err == nil {
 		return nil
 	}
-	err = client.send(&protocol.MessageFromWrapClient{
-		Spec: &protocol.MessageFromWrapClient_FileReadDirResult{
-			FileReadDirResult: fileReadDirResult,
+	result := &protocol.FileError{Code: protocol.FileError_UNKNOWN, Message: err.Error()}
+	var editErr *fileEditError
+	switch {
+	case errors.As(err, &editErr):
+		result.Code = editErr.code
+	case errors.Is(err, fileTooBigError):
+		result.Code = protocol.FileError_TOO_BIG
+	case errors.Is(err, syscall.ENOTEMPTY):
+		// before ErrExist, which it also matches
+		result.Code = protocol.FileError_NOT_EMPTY
+	case errors.Is(err, os.ErrNotExist):
+		result.Code = protocol.FileError_NOT_FOUND
+	case errors.Is(err, os.ErrExist):
+		result.Code = protocol.FileError_ALREADY_EXISTS
+	case errors.Is(err, os.ErrPermission):
+		result.Code = protocol.FileError_PERMISSION_DENIED
+	case errors.Is(err, syscall.EISDIR):
+		result.Code = protocol.FileError_IS_DIRECTORY
+	}
+	return result
+}
+
+/* the result of a write or create, with what's needed to detect conflicts with the next write */
+func (client *Client) writtenFileResult(path string, content []byte) (*protocol.FileEditResult, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "stat")
+	}
+	return &protocol.FileEditResult{
+		Path:    path,
+		ModTime: info.ModTime().UnixNano(),
+		// like the hash of the file when it's read
+		Sha256: fileHash(client.redactor.redact(content)),
+	}, nil
+}
+
+/*
+Replaces a file's contents, or a byte range of them. If the request has the
+modification time or hash the file had when it was read, the write fails with
+a conflict if it has changed since. Files containing secrets can't be written,
+since the dashboard only saw them masked: saving would write the mask over the
+secrets, and patch offsets would be off.
+*/
+func (client *Client) writeFile(msg *protocol.FileWrite, maxFileSize int64) (*protocol.FileEditResult, error) {
+	path := msg.GetPath()
+	if path == "" {
+		return nil, newFileEditError(protocol.FileError_INVALID_REQUEST, "no path given")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "stat")
+	}
+	if info.IsDir() {
+		return nil, newFileEditError(protocol.FileError_IS_DIRECTORY, "%v is a directory", path)
+	}
+	if msg.GetExpectedModTime() != 0 && msg.GetExpectedModTime() != info.ModTime().UnixNano() {
+		return nil, newFileEditError(protocol.FileError_CONFLICT, "%v was modified since it was read", path)
+	}
+	if info.Size() > maxFileSize {
+		return nil, fileTooBigError
+	}
+	current, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "file read")
+	}
+	if client.redactor != nil && !bytes.Equal(client.redactor.redact(current), current) {
+		return nil, newFileEditError(protocol.FileError_CONFLICT, "%v contains redacted secrets", path)
+	}
+	if msg.GetExpectedSha256() != "" && msg.GetExpectedSha256() != fileHash(current) {
+		return nil, newFileEditError(protocol.FileError_CONFLICT, "%v was modified since it was read", path)
+	}
+	content := msg.GetData()
+	if msg.GetPatch() {
+		start, end := msg.GetOffset(), msg.GetOffset()+msg.GetLength()
+		if end < start || end > uint64(len(current)) {
+			return nil, newFileEditError(protocol.FileError_INVALID_REQUEST,
+				"the patch at %v+%v is beyond the end of %v (%v bytes)", msg.GetOffset(), msg.GetLength(), path, len(current))
+		}
+		content = make([]byte, 0, uint64(len(current))-msg.GetLength()+uint64(len(msg.GetData())))
+		content = append(content, current[:start]...)
+		content = append(content, msg.GetData()...)
+		content = append(content, current[end:]...)
+	}
+	if int64(len(content)) > maxFileSize {
+		return nil, fileTooBigError
+	}
+	// keeps the file's mode, unlike writing a new file and renaming it
+	err = ioutil.WriteFile(path, content, info.Mode().Perm())
+	if err != nil {
+		return nil, errors.Wrap(err, "file write")
+	}
+	return client.writtenFileResult(path, content)
+}
+
+func (client *Client) createFile(msg *protocol.FileCreate, maxFileSize int64) (*protocol.FileEditResult, error) {
+	path := msg.GetPath()
+	if path == "" {
+		return nil, newFileEditError(protocol.FileError_INVALID_REQUEST, "no path given")
+	}
+	if int64(len(msg.GetData())) > maxFileSize {
+		return nil, fileTooBigError
+	}
+	mode := os.FileMode(msg.GetMode()).Perm()
+	if mode == 0 {
+		mode = defaultFileMode
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode)
+	if err != nil {
+		return nil, errors.Wrap(err, "create")
+	}
+	_, err = f.Write(msg.GetData())
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "file write")
+	}
+	return client.writtenFileResult(path, msg.GetData())
+}
+
+func (client *Client) deleteFile(msg *protocol.FileDelete) (*protocol.FileEditResult, error) {
+	path := msg.GetPath()
+	if path == "" {
+		return nil, newFileEditError(protocol.FileError_INVALID_REQUEST, "no path given")
+	}
+	info, err := os.Lstat(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "stat")
+	}
+	if info.IsDir() && msg.GetRecursive() {
+		err = os.RemoveAll(path)
+	} else {
+		err = os.Remove(path)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "delete")
+	}
+	return &protocol.FileEditResult{Path: path}, nil
+}
+
+func (client *Client) renameFile(msg *protocol.FileRename) (*protocol.FileEditResult, error) {
+	path, newPath := msg.GetPath(), msg.GetNewPath()
+	if path == "" || newPath == "" {
+		return nil, newFileEditError(protocol.FileError_INVALID_REQUEST, "no path given")
+	}
+	if _, err := os.Lstat(path); err != nil {
+		return nil, errors.Wrap(err, "stat")
+	}
+	if !msg.GetOverwrite() {
+		if _, err := os.Lstat(newPath); err == nil {
+			return nil, newFileEditError(protocol.FileError_ALREADY_EXISTS, "%v already exists", newPath)
+		}
+	}
+	err := os.Rename(path, newPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "rename")
+	}
+	return &protocol.FileEditResult{Path: newPath}, nil
+}
+
+func (client *Client) makeDir(msg *protocol.MakeDir) (*protocol.FileEditResult, error) {
+	path := msg.GetPath()
+	if path == "" {
+		return nil, newFileEditError(protocol.FileError_INVALID_REQUEST, "no path given")
+	}
+	mode := os.FileMode(msg.GetMode()).Perm()
+	if mode == 0 {
+		mode = defaultDirMode
+	}
+	var err error
+	if msg.GetParents() {
+		err = os.MkdirAll(path, mode)
+	} else {
+		err = os.Mkdir(path, mode)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "make directory")
+	}
+	return &protocol.FileEditResult{Path: path}, nil
+}
+
+/* replies to a file edit request with its result, or the error it failed with */
+func (client *Client) sendFileEditResult(requestId uint32, path string, result *protocol.FileEditResult, err error, listenerId uint32) {
+	if err != nil {
+		client.debugLog("file edit of %v failed: %v", path, err)
+		result = &protocol.FileEditResult{
+			Path:  path,
+			Error: fileError(err),
+		}
+	}
+	result.RequestId = requestId
+	sendErr := client.send(&protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_FileEditResult{
+			FileEditResult: result,
 		},
 		ListenerId: listenerId,
 	})
-	if err != nil {
-		panic(errors.Wrap(err, "send file-read-dir result"))
+	if sendErr != nil {
+		client.debugLog(errors.Wrap(sendErr, "send file edit result").Error())
+	}
+}
+
+func (client *Client) handleFileWrite(msg *protocol.FileWrite, listenerId uint32) error {
+	result, err := client.writeFile(msg, maxFileReadSize)
+	if err == nil {
+		client.trackEdit(msg.GetPath())
+		client.Log("%v was written from the dashboard.", msg.GetPath())
+	}
+	client.sendFileEditResult(msg.GetRequestId(), msg.GetPath(), result, err, listenerId)
+	return nil
+}
+
+func (client *Client) handleFileCreate(msg *protocol.FileCreate, listenerId uint32) error {
+	result, err := client.createFile(msg, maxFileReadSize)
+	if err == nil {
+		client.trackEdit(msg.GetPath())
+		client.Log("%v was created from the dashboard.", msg.GetPath())
+	}
+	client.sendFileEditResult(msg.GetRequestId(), msg.GetPath(), result, err, listenerId)
+	return nil
+}
+
+func (client *Client) handleFileDelete(msg *protocol.FileDelete, listenerId uint32) error {
+	result, err := client.deleteFile(msg)
+	if err == nil {
+		client.trackEdit(msg.GetPath())
+		client.Log("%v was deleted from the dashboard.", msg.GetPath())
+	}
+	client.sendFileEditResult(msg.GetRequestId(), msg.GetPath(), result, err, listenerId)
+	return nil
+}
+
+func (client *Client) handleFileRename(msg *protocol.FileRename, listenerId uint32) error {
+	result, err := client.renameFile(msg)
+	if err == nil {
+		client.trackEdit(msg.GetPath(), msg.GetNewPath())
+		client.Log("%v was renamed to %v from the dashboard.", msg.GetPath(), msg.GetNewPath())
+	}
+	client.sendFileEditResult(msg.GetRequestId(), msg.GetPath(), result, err, listenerId)
+	return nil
+}
+
+func (client *Client) handleMakeDir(msg *protocol.MakeDir, listenerId uint32) error {
+	result, err := client.makeDir(msg)
+	if err == nil {
+		client.Log("%v was made from the dashboard.", msg.GetPath())
 	}
+	client.sendFileEditResult(msg.GetRequestId(), msg.GetPath(), result, err, listenerId)
 	return nil
 }
@@ -0,0 +1,330 @@
+package wrap
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"hash"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+const fileChunkSize = 256 * 1024
+
+// chunks sent before waiting for the dashboard to acknowledge them, unless the request sets it
+const defaultDownloadWindow = 16
+
+// downloads are abandoned if the dashboard stops acknowledging chunks, e.g. because it was closed
+const downloadAckTimeout = time.Minute
+
+var downloadCancelledError = errors.New("download cancelled")
+
+// identifies a download, see protocol.FileDownload
+type downloadKey struct {
+	listenerId uint32
+	downloadId uint32
+}
+
+type download struct {
+	key    downloadKey
+	window uint64
+
+	mutex sync.Mutex
+	// the last chunk allowed to be sent, and the last one acknowledged
+	sent      uint64
+	acked     uint64
+	cancelled bool
+	// signalled whenever chunks are acknowledged
+	changed chan struct{}
+}
+
+func (d *download) ack(seq uint64, cancel bool) {
+	d.mutex.Lock()
+	if seq > d.sent {
+		// chunks which weren't sent yet can't have been received
+		seq = d.sent
+	}
+	if seq > d.acked {
+		d.acked = seq
+	}
+	if cancel {
+		d.cancelled = true
+	}
+	d.mutex.Unlock()
+	select {
+	case d.changed <- struct{}{}:
+	default:
+	}
+}
+
+/* blocks until the chunk with the given seq can be sent without exceeding the window */
+func (d *download) waitForWindow(seq uint64, closed <-chan struct{}) error {
+	for {
+		d.mutex.Lock()
+		cancelled, acked := d.cancelled, d.acked
+		d.mutex.Unlock()
+		if cancelled {
+			return downloadCancelledError
+		}
+		if seq-acked <= d.window {
+			d.mutex.Lock()
+			if seq > d.sent {
+				d.sent = seq
+			}
+			d.mutex.Unlock()
+			return nil
+		}
+		select {
+		case <-d.changed:
+		case <-closed:
+			return downloadCancelledError
+		case <-time.After(downloadAckTimeout):
+			return errors.Errorf("no chunks were acknowledged for %v", downloadAckTimeout)
+		}
+	}
+}
+
+func (client *Client) sendFileChunk(chunk *protocol.FileChunk, listenerId uint32) error {
+	return client.send(&protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_FileChunk{
+			FileChunk: chunk,
+		},
+		ListenerId: listenerId,
+	})
+}
+
+/*
+Starts streaming a file to the listener which requested it, in chunks.
+Only a window of chunks is sent ahead of the acknowledged ones, so files
+of any size can be downloaded without reading them into memory.
+*/
+func (client *Client) handleFileDownload(msg *protocol.FileDownload, listenerId uint32) error {
+	key := downloadKey{listenerId: listenerId, downloadId: msg.GetDownloadId()}
+	// secrets can start before the offset, so they're looked for in a margin before it
+	offset := msg.GetOffset()
+	from := offset
+	if margin := uint64(client.redactor.margin()); from > margin {
+		from -= margin
+	} else {
+		from = 0
+	}
+	f, err := client.openDownload(msg.GetPath(), from)
+	if err != nil {
+		err = client.sendFileChunk(&protocol.FileChunk{
+			DownloadId: msg.GetDownloadId(),
+			Done:       true,
+			Error:      err.Error(),
+		}, listenerId)
+		return errors.Wrap(err, "send file chunk")
+	}
+	d, err := client.addDownload(key, msg.GetWindow())
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	go client.streamDownload(d, f, from, offset)
+	return nil
+}
+
+func (client *Client) addDownload(key downloadKey, window uint32) (*download, error) {
+	d := &download{
+		key:     key,
+		window:  uint64(window),
+		changed: make(chan struct{}, 1),
+	}
+	if d.window == 0 {
+		d.window = defaultDownloadWindow
+	}
+	client.downloadsMutex.Lock()
+	defer client.downloadsMutex.Unlock()
+	if client.downloads == nil {
+		client.downloads = map[downloadKey]*download{}
+	}
+	if _, ok := client.downloads[key]; ok {
+		return nil, errors.Errorf("download %v is already running", key.downloadId)
+	}
+	client.downloads[key] = d
+	return d, nil
+}
+
+func (client *Client) removeDownload(d *download) {
+	client.downloadsMutex.Lock()
+	delete(client.downloads, d.key)
+	client.downloadsMutex.Unlock()
+}
+
+func (client *Client) openDownload(path string, offset uint64) (*os.File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, errors.Wrap(err, "stat")
+	}
+	if info.IsDir() {
+		_ = f.Close()
+		return nil, errors.Errorf("%v is a directory", path)
+	}
+	if offset > 0 {
+		_, err = f.Seek(int64(offset), io.SeekStart)
+		if err != nil {
+			_ = f.Close()
+			return nil, errors.Wrap(err, "seek")
+		}
+	}
+	return f, nil
+}
+
+/* streams the file read from offset, sending what was read from start on */
+func (client *Client) streamDownload(d *download, f *os.File, offset uint64, start uint64) {
+	defer func() {
+		_ = f.Close()
+		client.removeDownload(d)
+	}()
+	redaction := client.redactor.stream()
+	redaction.skip = int(start - offset)
+	hash := sha256.New()
+	buf := make([]byte, fileChunkSize)
+	for seq := uint64(1); ; seq++ {
+		chunk := &protocol.FileChunk{
+			DownloadId: d.key.downloadId,
+			Seq:        seq,
+			// output held back for redaction comes first
+			Offset: offset + uint64(redaction.skip) - uint64(len(redaction.pending)),
+		}
+		err := d.waitForWindow(seq, client.closedChan)
+		if err == downloadCancelledError {
+			client.debugLog("download %v was cancelled", d.key.downloadId)
+			return
+		}
+		var n int
+		if err == nil {
+			n, err = f.Read(buf)
+		}
+		offset += uint64(n)
+		data := redaction.write(buf[:n])
+		if err == io.EOF {
+			data = append(data, redaction.flush()...)
+			chunk.Done = true
+		} else if err != nil {
+			chunk.Done = true
+			chunk.Error = err.Error()
+		}
+		chunk.Data = data
+		hash.Write(data)
+		if chunk.Done && chunk.Error == "" {
+			chunk.Sha256 = hex.EncodeToString(hash.Sum(nil))
+			chunk.Redacted = redaction.masked > 0
+		}
+		err = client.sendFileChunk(chunk, d.key.listenerId)
+		if err != nil {
+			client.debugLog(errors.Wrap(err, "send file chunk").Error())
+			return
+		}
+		if chunk.Done {
+			return
+		}
+	}
+}
+
+/*
+Sends what's written to it as a download's chunks, e.g. an archive as it's
+being compressed. Writes block while the window is full.
+*/
+type chunkWriter struct {
+	client *Client
+	d      *download
+	seq    uint64
+	offset uint64
+	hash   hash.Hash
+	buf    []byte
+	// reported with the last chunk
+	redacted bool
+}
+
+func (client *Client) newChunkWriter(d *download) *chunkWriter {
+	return &chunkWriter{
+		client: client,
+		d:      d,
+		hash:   sha256.New(),
+		buf:    make([]byte, 0, fileChunkSize),
+	}
+}
+
+func (w *chunkWriter) Write(b []byte) (int, error) {
+	written := 0
+	for len(b) > 0 {
+		n := fileChunkSize - len(w.buf)
+		if n > len(b) {
+			n = len(b)
+		}
+		w.buf = append(w.buf, b[:n]...)
+		b = b[n:]
+		written += n
+		if len(w.buf) == fileChunkSize {
+			if err := w.send(false, nil); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+/* sends the buffered data, as the last chunk if done, or with the error the download failed with */
+func (w *chunkWriter) send(done bool, failure error) error {
+	w.seq++
+	err := w.d.waitForWindow(w.seq, w.client.closedChan)
+	if err == downloadCancelledError {
+		return err
+	}
+	chunk := &protocol.FileChunk{
+		DownloadId: w.d.key.downloadId,
+		Seq:        w.seq,
+		Offset:     w.offset,
+		Data:       w.buf,
+	}
+	if err == nil {
+		err = failure
+	}
+	if err != nil {
+		chunk.Data = nil
+		chunk.Done = true
+		chunk.Error = err.Error()
+	} else if done {
+		w.hash.Write(w.buf)
+		chunk.Done = true
+		chunk.Sha256 = hex.EncodeToString(w.hash.Sum(nil))
+		chunk.Redacted = w.redacted
+	} else {
+		w.hash.Write(w.buf)
+	}
+	w.offset += uint64(len(chunk.Data))
+	sendErr := w.client.sendFileChunk(chunk, w.d.key.listenerId)
+	w.buf = w.buf[:0]
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(sendErr, "send file chunk")
+}
+
+/* sends the last chunk, with the error the download failed with if it's not nil */
+func (w *chunkWriter) close(failure error) error {
+	return w.send(true, failure)
+}
+
+func (client *Client) handleFileChunkAck(msg *protocol.FileChunkAck, listenerId uint32) error {
+	client.downloadsMutex.Lock()
+	d := client.downloads[downloadKey{listenerId: listenerId, downloadId: msg.GetDownloadId()}]
+	client.downloadsMutex.Unlock()
+	if d == nil {
+		client.debugLog("acknowledgment for finished download %v", msg.GetDownloadId())
+		return nil
+	}
+	d.ack(msg.GetSeq(), msg.GetCancel())
+	return nil
+}
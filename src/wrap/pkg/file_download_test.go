@@ -0,0 +1,38 @@
+package wrap
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDownloadWindow(t *testing.T) {
+	d := &download{window: 2, changed: make(chan struct{}, 1)}
+	closed := make(chan struct{})
+	assertNil(t, "first chunk", d.waitForWindow(2, closed))
+	waited := make(chan error)
+	go func() {
+		waited <- d.waitForWindow(3, closed)
+	}()
+	select {
+	case <-waited:
+		t.Fatal("the third chunk was sent before the first was acknowledged")
+	case <-time.After(20 * time.Millisecond):
+	}
+	d.ack(1, false)
+	assertNil(t, "third chunk", <-waited)
+	// acknowledging chunks which weren't sent doesn't open the window any further
+	d.ack(10, false)
+	assertNil(t, "fourth chunk", d.waitForWindow(4, closed))
+	go func() {
+		waited <- d.waitForWindow(6, closed)
+	}()
+	select {
+	case <-waited:
+		t.Fatal("the sixth chunk was sent before the fourth was acknowledged")
+	case <-time.After(20 * time.Millisecond):
+	}
+	d.ack(4, false)
+	assertNil(t, "sixth chunk", <-waited)
+	d.ack(1, true)
+	assertEqual(t, "cancelled", downloadCancelledError, d.waitForWindow(4, closed))
+}
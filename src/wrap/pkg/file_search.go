@@ -0,0 +1,448 @@
+package wrap
+
+import (
+	"bufio"
+	"bytes"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+)
+
+// matches sent for a search, unless the request sets a limit
+const defaultMaxSearchResults = 1000
+
+// matches are sent in batches of up to this many, or whatever was found in this time
+const searchResultBatchSize = 100
+const searchResultInterval = 250 * time.Millisecond
+
+// files with a NUL byte this close to the start are treated as binary, like git does
+const binaryCheckSize = 8000
+
+// lines are cut to this many bytes before they're sent, and files with longer ones aren't searched further
+const maxGrepLineLength = 1024
+const maxGrepScanLine = 1024 * 1024
+
+var searchCancelledError = errors.New("search cancelled")
+var searchLimitError = errors.New("too many results")
+
+// identifies a search, see protocol.FileSearch
+type searchKey struct {
+	listenerId uint32
+	searchId   uint32
+}
+
+type search struct {
+	key    searchKey
+	client *Client
+	// set once the dashboard cancels the search
+	cancelled      int32
+	includeIgnored bool
+	maxResults     uint32
+	found          uint32
+	result         *protocol.FileSearchResult
+	lastSent       time.Time
+	send           func(*protocol.FileSearchResult)
+}
+
+func (s *search) isCancelled() bool {
+	return atomic.LoadInt32(&s.cancelled) != 0
+}
+
+func (s *search) cancel() {
+	atomic.StoreInt32(&s.cancelled, 1)
+}
+
+/* adds a match to the results, returning searchLimitError once there are enough */
+func (s *search) add(match *protocol.FileMatch) error {
+	s.result.Match = append(s.result.Match, match)
+	s.found++
+	if len(s.result.Match) >= searchResultBatchSize || time.Since(s.lastSent) > searchResultInterval {
+		s.flush(false)
+	}
+	if s.found >= s.maxResults {
+		return searchLimitError
+	}
+	return nil
+}
+
+/* sends the matches found since the last batch */
+func (s *search) flush(done bool) {
+	s.result.Done = done
+	s.send(s.result)
+	s.lastSent = time.Now()
+	s.result = &protocol.FileSearchResult{
+		SearchId:      s.key.searchId,
+		FilesSearched: s.result.FilesSearched,
+	}
+}
+
+/*
+Walks a directory, visiting everything in it which isn't ignored by
+.gitignore files, unless ignored files were asked for. Symlinks aren't
+followed.
+*/
+func (s *search) walk(dir string, rel string, ignores []*ignoreFile, visit func(string, string, os.FileInfo) error) error {
+	if s.isCancelled() {
+		return searchCancelledError
+	}
+	if !s.includeIgnored && rel != "" {
+		if ignore := readIgnoreFile(dir); ignore != nil {
+			ignores = append(ignores[:len(ignores):len(ignores)], ignore)
+		}
+	}
+	f, err := os.Open(dir)
+	if err != nil {
+		s.client.debugLog("search: skipping %v: %v", dir, err)
+		return nil
+	}
+	names, err := f.Readdirnames(-1)
+	_ = f.Close()
+	if err != nil {
+		s.client.debugLog("search: skipping %v: %v", dir, err)
+		return nil
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		// checked before every entry, a directory can hold thousands of files
+		if s.isCancelled() {
+			return searchCancelledError
+		}
+		if name == ".git" {
+			continue
+		}
+		p := filepath.Join(dir, name)
+		r := path.Join(rel, name)
+		info, err := os.Lstat(p)
+		if err != nil {
+			continue
+		}
+		if !s.includeIgnored && isIgnored(ignores, p, info.IsDir()) {
+			continue
+		}
+		err = visit(p, r, info)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			err = s.walk(p, r, ignores, visit)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+/* searches the directory, then sends the last results */
+func (s *search) run(dir string, visit func(string, string, os.FileInfo) error) {
+	defer s.client.removeSearch(s)
+	if abs, err := filepath.Abs(dir); err == nil {
+		// so that the .gitignore files in the directories above are found
+		dir = abs
+	}
+	var ignores []*ignoreFile
+	if !s.includeIgnored {
+		ignores = parentIgnoreFiles(dir)
+	}
+	err := s.walk(dir, "", ignores, visit)
+	switch err {
+	case searchCancelledError:
+		s.client.debugLog("search %v was cancelled", s.key.searchId)
+		return
+	case searchLimitError:
+		s.result.Truncated = true
+	case nil:
+	default:
+		s.result.Error = err.Error()
+	}
+	s.flush(true)
+}
+
+/*
+Returns how well a path matches the pattern's characters in order, ignoring
+case, and whether it matches at all. Characters at the start of words, in a
+row, or in the file's name count for more.
+*/
+func fuzzyScore(pattern string, rel string) (int32, bool) {
+	p := []rune(strings.ToLower(pattern))
+	t := []rune(strings.ToLower(rel))
+	score, matched, previous := int32(0), 0, -2
+	for i, c := range t {
+		if matched == len(p) {
+			break
+		}
+		if c != p[matched] {
+			continue
+		}
+		score++
+		if i == previous+1 {
+			score += 2
+		}
+		if i == 0 || strings.ContainsRune("/_-. ", t[i-1]) {
+			score += 3
+		}
+		previous = i
+		matched++
+	}
+	if matched < len(p) {
+		return 0, false
+	}
+	if strings.Contains(strings.ToLower(path.Base(rel)), strings.ToLower(pattern)) {
+		score += 10
+	}
+	return score - int32(len(t)/8), true
+}
+
+/* makes a line safe to send: valid UTF-8, and not too long */
+func grepLine(line string) string {
+	line = strings.ToValidUTF8(line, "�")
+	if len(line) <= maxGrepLineLength {
+		return line
+	}
+	cut := maxGrepLineLength
+	for cut > 0 && !utf8.RuneStart(line[cut]) {
+		cut--
+	}
+	return line[:cut]
+}
+
+/*
+Searches a file's lines, adding those matching to the results with the
+given number of lines of context. Binary files are skipped.
+*/
+func (s *search) grepFile(p string, rel string, re *regexp.Regexp, contextLines int) error {
+	f, err := os.Open(p)
+	if err != nil {
+		s.client.debugLog("search: skipping %v: %v", p, err)
+		return nil
+	}
+	defer f.Close()
+	head := make([]byte, binaryCheckSize)
+	n, err := io.ReadFull(f, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil
+	}
+	head = head[:n]
+	if bytes.IndexByte(head, 0) >= 0 {
+		return nil
+	}
+	s.result.FilesSearched++
+	// the file is redacted before it's split into lines, a secret can span several
+	scanner := bufio.NewScanner(s.client.redactor.reader(io.MultiReader(bytes.NewReader(head), f)))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxGrepScanLine)
+	var before []string
+	// matches still collecting lines of context after them
+	var pending []*protocol.FileMatch
+	for lineNumber := uint32(1); scanner.Scan(); lineNumber++ {
+		if lineNumber%1000 == 0 && s.isCancelled() {
+			return searchCancelledError
+		}
+		line := grepLine(scanner.Text())
+		for _, match := range pending {
+			match.After = append(match.After, line)
+		}
+		for len(pending) > 0 && len(pending[0].After) >= contextLines {
+			if err := s.add(pending[0]); err != nil {
+				return err
+			}
+			pending = pending[1:]
+		}
+		if indexes := re.FindAllStringIndex(line, -1); len(indexes) > 0 {
+			match := &protocol.FileMatch{
+				Path:       rel,
+				LineNumber: lineNumber,
+				Line:       line,
+				Before:     append([]string{}, before...),
+			}
+			for _, index := range indexes {
+				match.Range = append(match.Range, &protocol.TextRange{Start: uint32(index[0]), End: uint32(index[1])})
+			}
+			if contextLines == 0 {
+				if err := s.add(match); err != nil {
+					return err
+				}
+			} else {
+				pending = append(pending, match)
+			}
+		}
+		if contextLines > 0 {
+			before = append(before, line)
+			if len(before) > contextLines {
+				before = before[1:]
+			}
+		}
+	}
+	// e.g. a line which was too long, what was found so far is still sent
+	if err := scanner.Err(); err != nil {
+		s.client.debugLog("search: stopped reading %v: %v", p, err)
+	}
+	for _, match := range pending {
+		if err := s.add(match); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/* registers a search, cancelling any running one with the same id */
+func (client *Client) startSearch(key searchKey, maxResults uint32, includeIgnored bool) *search {
+	s := &search{
+		key:            key,
+		client:         client,
+		includeIgnored: includeIgnored,
+		maxResults:     maxResults,
+		result:         &protocol.FileSearchResult{SearchId: key.searchId},
+		lastSent:       time.Now(),
+	}
+	if s.maxResults == 0 {
+		s.maxResults = defaultMaxSearchResults
+	}
+	s.send = func(result *protocol.FileSearchResult) {
+		client.sendFileSearchResult(result, key.listenerId)
+	}
+	client.searchesMutex.Lock()
+	defer client.searchesMutex.Unlock()
+	if client.searches == nil {
+		client.searches = map[searchKey]*search{}
+	}
+	if old := client.searches[key]; old != nil {
+		old.cancel()
+	}
+	client.searches[key] = s
+	return s
+}
+
+func (client *Client) removeSearch(s *search) {
+	client.searchesMutex.Lock()
+	if client.searches[s.key] == s {
+		delete(client.searches, s.key)
+	}
+	client.searchesMutex.Unlock()
+}
+
+func (client *Client) sendFileSearchResult(result *protocol.FileSearchResult, listenerId uint32) {
+	err := client.send(&protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_FileSearchResult{
+			FileSearchResult: result,
+		},
+		ListenerId: listenerId,
+	})
+	if err != nil {
+		client.debugLog(errors.Wrap(err, "send file search result").Error())
+	}
+}
+
+/* replies to a search which couldn't be started */
+func (client *Client) failSearch(searchId uint32, err error, listenerId uint32) error {
+	client.sendFileSearchResult(&protocol.FileSearchResult{
+		SearchId: searchId,
+		Done:     true,
+		Error:    err.Error(),
+	}, listenerId)
+	return nil
+}
+
+func checkSearchDir(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return errors.Wrap(err, "stat")
+	}
+	if !info.IsDir() {
+		return errors.Errorf("%v is not a directory", dir)
+	}
+	return nil
+}
+
+/* returns a function matching paths against the search's pattern, returning nil if they don't */
+func fileSearchMatcher(msg *protocol.FileSearch) (func(string, os.FileInfo) *protocol.FileMatch, error) {
+	if msg.GetFuzzy() {
+		return func(rel string, info os.FileInfo) *protocol.FileMatch {
+			score, ok := fuzzyScore(msg.GetPattern(), rel)
+			if !ok {
+				return nil
+			}
+			return &protocol.FileMatch{Path: rel, IsDir: info.IsDir(), Score: score}
+		}, nil
+	}
+	globs, err := compileGlobs([]string{msg.GetPattern()})
+	if err != nil {
+		return nil, err
+	}
+	return func(rel string, info os.FileInfo) *protocol.FileMatch {
+		if !matchesAnyGlob(globs, rel) {
+			return nil
+		}
+		return &protocol.FileMatch{Path: rel, IsDir: info.IsDir()}
+	}, nil
+}
+
+func (client *Client) handleFileSearch(msg *protocol.FileSearch, listenerId uint32) error {
+	err := checkSearchDir(msg.GetPath())
+	if err != nil {
+		return client.failSearch(msg.GetSearchId(), err, listenerId)
+	}
+	matcher, err := fileSearchMatcher(msg)
+	if err != nil {
+		return client.failSearch(msg.GetSearchId(), errors.Wrap(err, "invalid pattern"), listenerId)
+	}
+	key := searchKey{listenerId: listenerId, searchId: msg.GetSearchId()}
+	s := client.startSearch(key, msg.GetMaxResults(), msg.GetIncludeIgnored())
+	go s.run(msg.GetPath(), func(p string, rel string, info os.FileInfo) error {
+		s.result.FilesSearched++
+		if match := matcher(rel, info); match != nil {
+			return s.add(match)
+		}
+		return nil
+	})
+	return nil
+}
+
+func (client *Client) handleFileGrep(msg *protocol.FileGrep, listenerId uint32) error {
+	err := checkSearchDir(msg.GetPath())
+	if err != nil {
+		return client.failSearch(msg.GetSearchId(), err, listenerId)
+	}
+	pattern := msg.GetPattern()
+	if msg.GetIgnoreCase() {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return client.failSearch(msg.GetSearchId(), errors.Wrap(err, "invalid pattern"), listenerId)
+	}
+	include, err := compileGlobs(msg.GetInclude())
+	if err != nil {
+		return client.failSearch(msg.GetSearchId(), err, listenerId)
+	}
+	key := searchKey{listenerId: listenerId, searchId: msg.GetSearchId()}
+	s := client.startSearch(key, msg.GetMaxResults(), msg.GetIncludeIgnored())
+	go s.run(msg.GetPath(), func(p string, rel string, info os.FileInfo) error {
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		if len(include) > 0 && !matchesAnyGlob(include, rel) {
+			return nil
+		}
+		return s.grepFile(p, rel, re, int(msg.GetContextLines()))
+	})
+	return nil
+}
+
+func (client *Client) handleFileSearchCancel(msg *protocol.FileSearchCancel, listenerId uint32) error {
+	client.searchesMutex.Lock()
+	s := client.searches[searchKey{listenerId: listenerId, searchId: msg.GetSearchId()}]
+	client.searchesMutex.Unlock()
+	if s != nil {
+		s.cancel()
+	}
+	return nil
+}
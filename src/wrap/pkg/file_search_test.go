@@ -0,0 +1,215 @@
+package wrap
+
+import (
+	"fmt"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func makeSearchTree(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "Wrap.TestFileSearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		os.MkdirAll(filepath.Dir(p), 0755)
+		ioutil.WriteFile(p, []byte(content), 0644)
+	}
+	return dir
+}
+
+/* collects the results the client sends for a search until it's done, returning the paths (and lines) found */
+func collectSearch(t *testing.T, messages <-chan *protocol.MessageFromWrapClient) ([]string, *protocol.FileSearchResult) {
+	var found []string
+	for {
+		result := waitForMessage(t, messages, func(msg *protocol.MessageFromWrapClient) bool {
+			return msg.GetFileSearchResult() != nil
+		}).GetFileSearchResult()
+		for _, match := range result.Match {
+			entry := match.Path
+			if match.LineNumber > 0 {
+				entry += ":" + strings.Join(append(append(match.Before, match.Line), match.After...), "|")
+			}
+			found = append(found, entry)
+		}
+		if result.Done {
+			return found, result
+		}
+	}
+}
+
+func TestFileSearchGitignore(t *testing.T) {
+	dir := makeSearchTree(t, map[string]string{
+		".gitignore":                          "node_modules/\n*.log\n!keep.log\n",
+		"src/__snapshots__/app.snap":          "",
+		"node_modules/x/__snapshots__/a.snap": "",
+		"build.log":                           "",
+		"src/keep.log":                        "",
+	})
+	defer os.RemoveAll(dir)
+	c := newBlankTestClient()
+	messages, closeClient := connectTestClient(t, c)
+	defer closeClient()
+	err := c.handleFileSearch(&protocol.FileSearch{SearchId: 1, Path: dir, Pattern: "[ak]*.*"}, 0)
+	assertNil(t, "error", err)
+	found, last := collectSearch(t, messages)
+	assertEqual(t, "found", "src/__snapshots__/app.snap,src/keep.log", strings.Join(found, ","))
+	assertEqual(t, "error", "", last.Error)
+
+	score, ok := fuzzyScore("appsnap", "src/__snapshots__/app.snap")
+	assertEqual(t, "fuzzy match", true, ok)
+	assertEqual(t, "fuzzy score positive", true, score > 0)
+	_, ok = fuzzyScore("zzz", "src/app.snap")
+	assertEqual(t, "fuzzy mismatch", false, ok)
+}
+
+func TestFileSearchRelativeDir(t *testing.T) {
+	dir := makeSearchTree(t, map[string]string{
+		".git/HEAD":   "",
+		".gitignore":  "*.log\n",
+		"sub/a.log":   "",
+		"sub/..a.log": "",
+		"sub/a.txt":   "",
+		"sub/..a.txt": "",
+	})
+	defer os.RemoveAll(dir)
+	wd, err := os.Getwd()
+	assertNil(t, "error", err)
+	defer os.Chdir(wd)
+	assertNil(t, "error", os.Chdir(filepath.Join(dir, "sub")))
+	c := newBlankTestClient()
+	messages, closeClient := connectTestClient(t, c)
+	defer closeClient()
+	// the .gitignore file above the relative path still applies
+	err = c.handleFileSearch(&protocol.FileSearch{SearchId: 1, Path: ".", Pattern: "*a*"}, 0)
+	assertNil(t, "error", err)
+	found, _ := collectSearch(t, messages)
+	assertEqual(t, "found", "..a.txt,a.txt", strings.Join(found, ","))
+}
+
+func TestFileGrep(t *testing.T) {
+	dir := makeSearchTree(t, map[string]string{
+		"a.txt":      "one\ntwo\nthree\nfour\n",
+		"bin.dat":    "two\x00two",
+		"sub/b.txt":  "two\n",
+		"sub/c.md":   "two\n",
+		"sub/d/e.go": "three\n",
+	})
+	defer os.RemoveAll(dir)
+	c := newBlankTestClient()
+	messages, closeClient := connectTestClient(t, c)
+	defer closeClient()
+	err := c.handleFileGrep(&protocol.FileGrep{
+		SearchId:     1,
+		Path:         dir,
+		Pattern:      "t(wo|hree)",
+		ContextLines: 1,
+		Include:      []string{"*.txt", "sub/*/*.go", "*.dat"},
+	}, 0)
+	assertNil(t, "error", err)
+	found, last := collectSearch(t, messages)
+	assertEqual(t, "found", "a.txt:one|two|three,a.txt:two|three|four,sub/b.txt:two,sub/d/e.go:three", strings.Join(found, ","))
+	assertEqual(t, "files searched", uint64(3), last.FilesSearched)
+
+	err = c.handleFileGrep(&protocol.FileGrep{SearchId: 2, Path: dir, Pattern: "two", Include: []string{"[z-a]"}}, 0)
+	assertNil(t, "error", err)
+	_, last = collectSearch(t, messages)
+	assertEqual(t, "invalid include", true, strings.Contains(last.Error, "invalid glob"))
+}
+
+func TestFileGrepMultilineSecret(t *testing.T) {
+	key := "-----BEGIN KEY-----\nMIIEsecret1\nMIIEsecret2\n-----END KEY-----"
+	dir := makeSearchTree(t, map[string]string{
+		"deploy.pem": "# deploy key\n" + key + "\nMIIE is public\n",
+	})
+	defer os.RemoveAll(dir)
+	c := newBlankTestClient()
+	c.redactor = newRedactor([]string{key}, nil)
+	messages, closeClient := connectTestClient(t, c)
+	defer closeClient()
+	err := c.handleFileGrep(&protocol.FileGrep{SearchId: 1, Path: dir, Pattern: "MIIE|REDACTED"}, 0)
+	assertNil(t, "error", err)
+	found, _ := collectSearch(t, messages)
+	// the secret is masked as a whole, like when the file is read
+	assertEqual(t, "found", "deploy.pem:[REDACTED],deploy.pem:MIIE is public", strings.Join(found, ","))
+}
+
+func TestFileSearchCancel(t *testing.T) {
+	dir := makeSearchTree(t, map[string]string{
+		"a/b/match.txt": "",
+	})
+	defer os.RemoveAll(dir)
+	// the search stops to read the .gitignore file, until it's written
+	fifo := filepath.Join(dir, "a", ".gitignore")
+	if err := syscall.Mkfifo(fifo, 0644); err != nil {
+		t.Skip("can't make a fifo:", err)
+	}
+	c := newBlankTestClient()
+	messages, closeClient := connectTestClient(t, c)
+	defer closeClient()
+	err := c.handleFileSearch(&protocol.FileSearch{SearchId: 1, Path: dir, Pattern: "match.txt"}, 0)
+	assertNil(t, "error", err)
+	f, err := os.OpenFile(fifo, os.O_WRONLY, 0)
+	assertNil(t, "open error", err)
+	err = c.handleFileSearchCancel(&protocol.FileSearchCancel{SearchId: 1}, 0)
+	assertNil(t, "cancel error", err)
+	f.Close()
+
+	for deadline := time.Now().Add(10 * time.Second); ; {
+		c.searchesMutex.Lock()
+		running := len(c.searches)
+		c.searchesMutex.Unlock()
+		if running == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for the search to stop")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case msg := <-messages:
+		t.Fatalf("Expected no results after cancelling, got %v", msg)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestGlobRegexp(t *testing.T) {
+	for glob, matches := range map[string]map[string]bool{
+		"*.go":         {"main.go": true, "pkg/main.go": false},
+		"**/test/*.js": {"test/a.js": true, "src/test/a.js": true, "src/test/b/a.js": false},
+		"build/**":     {"build/a/b": true, "src/build/a": false},
+		"[!a]*.txt":    {"b.txt": true, "a.txt": false},
+	} {
+		re, err := globRegexp(glob)
+		assertNil(t, "error", err)
+		for p, expected := range matches {
+			assertEqual(t, glob+" matches "+p, expected, re.MatchString(p))
+		}
+	}
+}
+
+func TestFileSearchCancelInDirectory(t *testing.T) {
+	files := map[string]string{}
+	for i := 0; i < 100; i++ {
+		files[fmt.Sprintf("f%03d.txt", i)] = ""
+	}
+	dir := makeSearchTree(t, files)
+	defer os.RemoveAll(dir)
+	s := &search{includeIgnored: true}
+	visited := 0
+	err := s.walk(dir, "", nil, func(p string, rel string, info os.FileInfo) error {
+		visited++
+		s.cancel()
+		return nil
+	})
+	assertEqual(t, "error", searchCancelledError, err)
+	assertEqual(t, "visited", 1, visited)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/layer-devops/wrap.sh/src/protocol"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -65,3 +66,150 @@ func TestFileReadTooBig(t *testing.T) {
 	assertEqual(t, "err", fileTooBigError, err)
 	assertNil(t, "result", result)
 }
+
+func TestFileWritePatch(t *testing.T) {
+	c := newBlankTestClient()
+	f := makeTempFile(t, "hello world")
+	defer f.Remove()
+	read, err := c.readFile(&protocol.FileRead{Path: f.Path}, 100)
+	assertNil(t, "read error", err)
+	result, err := c.writeFile(&protocol.FileWrite{
+		Path:           f.Path,
+		Data:           []byte("there"),
+		Patch:          true,
+		Offset:         6,
+		Length:         5,
+		ExpectedSha256: read.Sha256,
+	}, 100)
+	assertNil(t, "write error", err)
+	content, _ := ioutil.ReadFile(f.Path)
+	assertEqual(t, "content", "hello there", string(content))
+	assertEqual(t, "sha256", fileHash(content), result.Sha256)
+
+	// the file has changed since it was read
+	_, err = c.writeFile(&protocol.FileWrite{
+		Path:           f.Path,
+		Data:           []byte("bye"),
+		ExpectedSha256: read.Sha256,
+	}, 100)
+	assertEqual(t, "conflict code", protocol.FileError_CONFLICT, fileError(err).Code)
+	_, err = c.writeFile(&protocol.FileWrite{Path: f.Path, Patch: true, Offset: 20}, 100)
+	assertEqual(t, "invalid patch code", protocol.FileError_INVALID_REQUEST, fileError(err).Code)
+}
+
+func TestFileWriteRedacted(t *testing.T) {
+	c := newBlankTestClient()
+	c.redactor = newRedactor([]string{"hunter22"}, nil)
+	f := makeTempFile(t, "password=hunter22\n")
+	defer f.Remove()
+	read, err := c.readFile(&protocol.FileRead{Path: f.Path}, 100)
+	assertNil(t, "read error", err)
+	assertEqual(t, "redacted", true, read.Redacted)
+	assertEqual(t, "sha256", fileHash(read.Data), read.Sha256)
+
+	// writing the masked contents back would replace the secret
+	_, err = c.writeFile(&protocol.FileWrite{Path: f.Path, Data: read.Data, ExpectedSha256: read.Sha256}, 100)
+	assertEqual(t, "write code", protocol.FileError_CONFLICT, fileError(err).Code)
+	_, err = c.writeFile(&protocol.FileWrite{Path: f.Path, Data: []byte("x"), Patch: true, Length: 1}, 100)
+	assertEqual(t, "patch code", protocol.FileError_CONFLICT, fileError(err).Code)
+	content, _ := ioutil.ReadFile(f.Path)
+	assertEqual(t, "content", "password=hunter22\n", string(content))
+}
+
+func TestFileCreateRenameDelete(t *testing.T) {
+	c := newBlankTestClient()
+	dir, err := ioutil.TempDir("", "Wrap.TestFileEdit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "a", "b")
+	_, err = c.makeDir(&protocol.MakeDir{Path: path, Parents: true})
+	assertNil(t, "make dir error", err)
+	file := filepath.Join(path, "config.json")
+	_, err = c.createFile(&protocol.FileCreate{Path: file, Data: []byte("{}")}, 100)
+	assertNil(t, "create error", err)
+	_, err = c.createFile(&protocol.FileCreate{Path: file}, 100)
+	assertEqual(t, "create again code", protocol.FileError_ALREADY_EXISTS, fileError(err).Code)
+	renamed := filepath.Join(dir, "config.json")
+	_, err = c.renameFile(&protocol.FileRename{Path: file, NewPath: renamed})
+	assertNil(t, "rename error", err)
+	_, err = c.deleteFile(&protocol.FileDelete{Path: filepath.Join(dir, "a")})
+	assertEqual(t, "delete non-empty code", protocol.FileError_NOT_EMPTY, fileError(err).Code)
+	_, err = c.deleteFile(&protocol.FileDelete{Path: filepath.Join(dir, "a"), Recursive: true})
+	assertNil(t, "delete error", err)
+	_, err = c.deleteFile(&protocol.FileDelete{Path: renamed})
+	assertNil(t, "delete file error", err)
+	_, err = os.Stat(renamed)
+	assertEqual(t, "deleted", true, os.IsNotExist(err))
+}
+
+func TestFileReadRange(t *testing.T) {
+	c := newBlankTestClient()
+	f := makeTempFile(t, "0123456789")
+	defer f.Remove()
+	result, err := c.readFile(&protocol.FileRead{Path: f.Path, Offset: 2, Length: 3}, 5)
+	assertNil(t, "error", err)
+	assertEqual(t, "data", "234", string(result.Data))
+	assertEqual(t, "offset", uint64(2), result.Offset)
+	assertEqual(t, "size", uint64(10), result.Size)
+	// the tail of a file bigger than the limit, capped at the limit
+	result, err = c.readFile(&protocol.FileRead{Path: f.Path, Offset: 8, FromEnd: true}, 5)
+	assertNil(t, "tail error", err)
+	assertEqual(t, "tail data", "23456", string(result.Data))
+	assertEqual(t, "tail offset", uint64(2), result.Offset)
+}
+
+func TestFileReadRangeRedacted(t *testing.T) {
+	c := newBlankTestClient()
+	c.redactor = newRedactor([]string{"hunter22"}, nil)
+	f := makeTempFile(t, "password=hunter22\n")
+	defer f.Remove()
+	// parts of the secret are masked even if the rest of it is outside the range
+	for _, read := range []struct {
+		msg  *protocol.FileRead
+		data string
+	}{
+		{&protocol.FileRead{Path: f.Path, Offset: 0, Length: 12}, "password=[REDACTED]"},
+		{&protocol.FileRead{Path: f.Path, Offset: 12, Length: 6}, "[REDACTED]\n"},
+		{&protocol.FileRead{Path: f.Path, Offset: 11, Length: 2}, "[REDACTED]"},
+		{&protocol.FileRead{Path: f.Path, Offset: 3, FromEnd: true}, "[REDACTED]\n"},
+	} {
+		result, err := c.readFile(read.msg, 100)
+		assertNil(t, "error", err)
+		assertEqual(t, "redacted", true, result.Redacted)
+		assertEqual(t, "data", read.data, string(result.Data))
+	}
+	result, err := c.readFile(&protocol.FileRead{Path: f.Path, Offset: 4, Length: 8}, 100)
+	assertNil(t, "error", err)
+	assertEqual(t, "data", "word=[REDACTED]", string(result.Data))
+	result, err = c.readFile(&protocol.FileRead{Path: f.Path, Offset: 2, Length: 3}, 100)
+	assertNil(t, "error", err)
+	assertEqual(t, "unmasked data", "ssw", string(result.Data))
+}
+
+func TestFileReadDir(t *testing.T) {
+	c := newBlankTestClient()
+	dir, err := ioutil.TempDir("", "Wrap.TestFileReadDir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.Mkdir(filepath.Join(dir, "empty"), 0755)
+	os.MkdirAll(filepath.Join(dir, "full", "x"), 0755)
+	ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("abc"), 0640)
+	os.Symlink("a.txt", filepath.Join(dir, "link"))
+	result, err := c.readFileDir(&protocol.FileReadDir{Path: dir})
+	assertNil(t, "error", err)
+	assertEqual(t, "entries", 4, len(result.Entry))
+	file, empty, full, link := result.Entry[0], result.Entry[1], result.Entry[2], result.Entry[3]
+	assertEqual(t, "file name", "a.txt", file.Name)
+	assertEqual(t, "file size", uint64(3), file.Size)
+	assertEqual(t, "file mode", "-rw-r-----", file.Mode)
+	assertEqual(t, "file type", "text/plain; charset=us-ascii", file.MimeType)
+	assertEqual(t, "file readable", true, file.Readable)
+	assertEqual(t, "file owner set", true, file.Owner != "")
+	assertEqual(t, "empty dir", true, empty.IsEmpty)
+	assertEqual(t, "full dir", false, full.IsEmpty)
+	assertEqual(t, "symlink target", "a.txt", link.SymlinkTarget)
+}
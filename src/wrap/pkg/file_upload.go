@@ -0,0 +1,240 @@
+package wrap
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"hash"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// uploads bigger than this are refused, unless MaxUploadSize is set
+const defaultMaxUploadSize = 1024 * 1024 * 1024
+
+// identifies an upload, see protocol.FileUploadBegin
+type uploadKey struct {
+	listenerId uint32
+	uploadId   uint32
+}
+
+/* an upload in progress, written to a temporary file next to its path until it's committed */
+type upload struct {
+	key      uploadKey
+	path     string
+	size     uint64
+	tmp      *os.File
+	seq      uint64
+	received uint64
+	hash     hash.Hash
+}
+
+func (u *upload) remove() {
+	_ = u.tmp.Close()
+	_ = os.Remove(u.tmp.Name())
+}
+
+func (client *Client) maxUploadSize() uint64 {
+	if client.MaxUploadSize > 0 {
+		return uint64(client.MaxUploadSize)
+	}
+	return defaultMaxUploadSize
+}
+
+func (client *Client) beginUpload(msg *protocol.FileUploadBegin, key uploadKey) (*upload, error) {
+	path := msg.GetPath()
+	if path == "" {
+		return nil, newFileEditError(protocol.FileError_INVALID_REQUEST, "no path given")
+	}
+	if msg.GetSize() > client.maxUploadSize() {
+		return nil, newFileEditError(protocol.FileError_TOO_BIG,
+			"%v bytes is more than the upload limit of %v bytes", msg.GetSize(), client.maxUploadSize())
+	}
+	mode := os.FileMode(msg.GetMode()).Perm()
+	info, err := os.Stat(path)
+	switch {
+	case err == nil && info.IsDir():
+		return nil, newFileEditError(protocol.FileError_IS_DIRECTORY, "%v is a directory", path)
+	case err == nil && !msg.GetOverwrite():
+		return nil, newFileEditError(protocol.FileError_ALREADY_EXISTS, "%v already exists", path)
+	case err == nil && mode == 0:
+		mode = info.Mode().Perm()
+	case err != nil && !os.IsNotExist(err):
+		return nil, errors.Wrap(err, "stat")
+	}
+	if mode == 0 {
+		mode = defaultFileMode
+	}
+	// in the same directory, so that it can be renamed into place
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".wrap-upload-")
+	if err != nil {
+		return nil, errors.Wrap(err, "create")
+	}
+	u := &upload{
+		key:  key,
+		path: path,
+		size: msg.GetSize(),
+		tmp:  tmp,
+		hash: sha256.New(),
+	}
+	err = tmp.Chmod(mode)
+	if err != nil {
+		u.remove()
+		return nil, errors.Wrap(err, "chmod")
+	}
+	return u, nil
+}
+
+func (client *Client) writeUploadChunk(u *upload, msg *protocol.FileUploadChunk) error {
+	if msg.GetSeq() != u.seq+1 {
+		return newFileEditError(protocol.FileError_INVALID_REQUEST, "expected chunk %v, got %v", u.seq+1, msg.GetSeq())
+	}
+	if u.received+uint64(len(msg.GetData())) > u.size {
+		return newFileEditError(protocol.FileError_INVALID_REQUEST, "more than the %v bytes announced were sent", u.size)
+	}
+	_, err := u.tmp.Write(msg.GetData())
+	if err != nil {
+		return errors.Wrap(err, "file write")
+	}
+	u.hash.Write(msg.GetData())
+	u.seq++
+	u.received += uint64(len(msg.GetData()))
+	return nil
+}
+
+/* checks the upload is complete and intact, then moves it into place */
+func (client *Client) commitUpload(u *upload, msg *protocol.FileUploadCommit) error {
+	if u.received != u.size {
+		return newFileEditError(protocol.FileError_INVALID_REQUEST, "only %v of %v bytes were sent", u.received, u.size)
+	}
+	if sum := hex.EncodeToString(u.hash.Sum(nil)); msg.GetSha256() != sum {
+		return newFileEditError(protocol.FileError_INVALID_REQUEST, "the checksum %q doesn't match the upload's, %v", msg.GetSha256(), sum)
+	}
+	err := u.tmp.Close()
+	if err != nil {
+		return errors.Wrap(err, "file write")
+	}
+	return errors.Wrap(os.Rename(u.tmp.Name(), u.path), "rename")
+}
+
+func (client *Client) getUpload(key uploadKey) *upload {
+	client.uploadsMutex.Lock()
+	defer client.uploadsMutex.Unlock()
+	return client.uploads[key]
+}
+
+/* forgets an upload, removing its temporary file unless it was committed */
+func (client *Client) removeUpload(u *upload, committed bool) {
+	client.uploadsMutex.Lock()
+	if client.uploads[u.key] == u {
+		delete(client.uploads, u.key)
+	}
+	client.uploadsMutex.Unlock()
+	if !committed {
+		u.remove()
+	}
+}
+
+/* removes the temporary files of unfinished uploads, e.g. when the client closes */
+func (client *Client) abortUploads() {
+	client.uploadsMutex.Lock()
+	uploads := client.uploads
+	client.uploads = nil
+	client.uploadsMutex.Unlock()
+	for _, u := range uploads {
+		u.remove()
+	}
+}
+
+func (client *Client) sendFileUploadResult(result *protocol.FileUploadResult, err error, listenerId uint32) {
+	if err != nil {
+		client.debugLog("upload to %v failed: %v", result.Path, err)
+		result.Error = fileError(err)
+		result.Aborted = true
+	}
+	sendErr := client.send(&protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_FileUploadResult{
+			FileUploadResult: result,
+		},
+		ListenerId: listenerId,
+	})
+	if sendErr != nil {
+		client.debugLog(errors.Wrap(sendErr, "send file upload result").Error())
+	}
+}
+
+func (client *Client) handleFileUploadBegin(msg *protocol.FileUploadBegin, listenerId uint32) error {
+	key := uploadKey{listenerId: listenerId, uploadId: msg.GetUploadId()}
+	if old := client.getUpload(key); old != nil {
+		client.removeUpload(old, false)
+	}
+	u, err := client.beginUpload(msg, key)
+	if err == nil {
+		client.uploadsMutex.Lock()
+		if client.uploads == nil {
+			client.uploads = map[uploadKey]*upload{}
+		}
+		client.uploads[key] = u
+		client.uploadsMutex.Unlock()
+	}
+	client.sendFileUploadResult(&protocol.FileUploadResult{
+		UploadId: msg.GetUploadId(),
+		Path:     msg.GetPath(),
+	}, err, listenerId)
+	return nil
+}
+
+func (client *Client) handleFileUploadChunk(msg *protocol.FileUploadChunk, listenerId uint32) error {
+	u := client.getUpload(uploadKey{listenerId: listenerId, uploadId: msg.GetUploadId()})
+	if u == nil {
+		client.debugLog("chunk for non-existent upload %v", msg.GetUploadId())
+		return nil
+	}
+	err := client.writeUploadChunk(u, msg)
+	if err != nil {
+		client.removeUpload(u, false)
+		client.sendFileUploadResult(&protocol.FileUploadResult{
+			UploadId: msg.GetUploadId(),
+			Path:     u.path,
+		}, err, listenerId)
+	}
+	return nil
+}
+
+func (client *Client) handleFileUploadCommit(msg *protocol.FileUploadCommit, listenerId uint32) error {
+	key := uploadKey{listenerId: listenerId, uploadId: msg.GetUploadId()}
+	u := client.getUpload(key)
+	if u == nil {
+		client.sendFileUploadResult(&protocol.FileUploadResult{UploadId: msg.GetUploadId()},
+			newFileEditError(protocol.FileError_INVALID_REQUEST, "upload %v isn't in progress", msg.GetUploadId()), listenerId)
+		return nil
+	}
+	err := client.commitUpload(u, msg)
+	client.removeUpload(u, err == nil)
+	if err == nil {
+		client.trackEdit(u.path)
+		client.Log("%v was uploaded from the dashboard.", u.path)
+	}
+	client.sendFileUploadResult(&protocol.FileUploadResult{
+		UploadId:  msg.GetUploadId(),
+		Path:      u.path,
+		Committed: err == nil,
+	}, err, listenerId)
+	return nil
+}
+
+func (client *Client) handleFileUploadAbort(msg *protocol.FileUploadAbort, listenerId uint32) error {
+	u := client.getUpload(uploadKey{listenerId: listenerId, uploadId: msg.GetUploadId()})
+	if u == nil {
+		return nil
+	}
+	client.removeUpload(u, false)
+	client.sendFileUploadResult(&protocol.FileUploadResult{
+		UploadId: msg.GetUploadId(),
+		Path:     u.path,
+		Aborted:  true,
+	}, nil, listenerId)
+	return nil
+}
@@ -0,0 +1,39 @@
+package wrap
+
+import (
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileUpload(t *testing.T) {
+	c := newBlankTestClient()
+	dir, err := ioutil.TempDir("", "Wrap.TestFileUpload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "fixture.json")
+	u, err := c.beginUpload(&protocol.FileUploadBegin{Path: path, Size: 6, Mode: 0600}, uploadKey{})
+	assertNil(t, "begin error", err)
+	assertNil(t, "first chunk error", c.writeUploadChunk(u, &protocol.FileUploadChunk{Seq: 1, Data: []byte("abc")}))
+	err = c.writeUploadChunk(u, &protocol.FileUploadChunk{Seq: 3, Data: []byte("def")})
+	assertEqual(t, "out of order code", protocol.FileError_INVALID_REQUEST, fileError(err).Code)
+	assertNil(t, "second chunk error", c.writeUploadChunk(u, &protocol.FileUploadChunk{Seq: 2, Data: []byte("def")}))
+	_, err = os.Stat(path)
+	assertEqual(t, "exists before commit", true, os.IsNotExist(err))
+	err = c.commitUpload(u, &protocol.FileUploadCommit{Sha256: fileHash([]byte("abcdef"))})
+	assertNil(t, "commit error", err)
+	content, _ := ioutil.ReadFile(path)
+	assertEqual(t, "content", "abcdef", string(content))
+	info, _ := os.Stat(path)
+	assertEqual(t, "mode", os.FileMode(0600), info.Mode().Perm())
+
+	_, err = c.beginUpload(&protocol.FileUploadBegin{Path: path, Size: 1}, uploadKey{})
+	assertEqual(t, "existing code", protocol.FileError_ALREADY_EXISTS, fileError(err).Code)
+	c.MaxUploadSize = 10
+	_, err = c.beginUpload(&protocol.FileUploadBegin{Path: path, Size: 11, Overwrite: true}, uploadKey{})
+	assertEqual(t, "too big code", protocol.FileError_TOO_BIG, fileError(err).Code)
+}
@@ -0,0 +1,403 @@
+package wrap
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const defaultDiffContextLines = 3
+
+/* runs git in dir, returning its output, or an error with what it printed */
+func runGit(dir string, args ...string) ([]byte, error) {
+	return runGitWithEnv(dir, nil, args...)
+}
+
+/*
+Runs git with extra environment variables, e.g. GIT_INDEX_FILE. Optional
+locks are turned off, so that commands like `git status` don't lock the index
+while the pipeline might be using the repository.
+*/
+func runGitWithEnv(dir string, env []string, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(append(os.Environ(), "GIT_OPTIONAL_LOCKS=0"), env...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, errors.Errorf("git %v: %v", args[0], message)
+		}
+		return nil, errors.Wrapf(err, "git %v", args[0])
+	}
+	return output, nil
+}
+
+/* returns the directory to run git in for a path, which defaults to the working directory */
+func gitDir(path string) (string, error) {
+	if path == "" {
+		return os.Getwd()
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", errors.Wrap(err, "stat")
+	}
+	if !info.IsDir() {
+		path = filepath.Dir(path)
+	}
+	return filepath.Abs(path)
+}
+
+func gitRoot(dir string) (string, error) {
+	output, err := runGit(dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+/* the branch a pull request is going to be merged into, if the CI provider says */
+func pullRequestTargetBranch() string {
+	target := coalesceEnv(
+		"GITHUB_BASE_REF",
+		"CI_MERGE_REQUEST_TARGET_BRANCH_NAME",
+		"BITBUCKET_PR_DESTINATION_BRANCH",
+		"SYSTEM_PULLREQUEST_TARGETBRANCH",
+		"BUILDKITE_PULL_REQUEST_BASE_BRANCH",
+		"CHANGE_TARGET",
+		"ghprbTargetBranch",
+		"DRONE_TARGET_BRANCH",
+	)
+	if target == "" && os.Getenv("TRAVIS_PULL_REQUEST") != "" && os.Getenv("TRAVIS_PULL_REQUEST") != "false" {
+		target = os.Getenv("TRAVIS_BRANCH")
+	}
+	return strings.TrimPrefix(target, "refs/heads/")
+}
+
+/* parses the output of `git status --porcelain -z` */
+func parseGitStatus(output []byte) []*protocol.GitFileStatus {
+	var files []*protocol.GitFileStatus
+	entries := strings.Split(string(output), "\x00")
+	for i := 0; i < len(entries); i++ {
+		entry := entries[i]
+		if len(entry) < 4 {
+			continue
+		}
+		file := &protocol.GitFileStatus{
+			Index:       entry[0:1],
+			WorkingTree: entry[1:2],
+			Path:        entry[3:],
+		}
+		// renames and copies are followed by the original path
+		if (file.Index == "R" || file.Index == "C") && i+1 < len(entries) {
+			i++
+			file.OriginalPath = entries[i]
+		}
+		files = append(files, file)
+	}
+	return files
+}
+
+func gitStatus(dir string, pathspec ...string) ([]*protocol.GitFileStatus, error) {
+	args := append([]string{"status", "--porcelain", "-z", "--untracked-files=normal", "--"}, pathspec...)
+	output, err := runGit(dir, args...)
+	if err != nil {
+		return nil, err
+	}
+	return parseGitStatus(output), nil
+}
+
+func (client *Client) gitStatus(msg *protocol.GitStatus) (*protocol.GitStatusResult, error) {
+	dir, err := gitDir(msg.GetPath())
+	if err != nil {
+		return nil, err
+	}
+	root, err := gitRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+	result := &protocol.GitStatusResult{Root: root}
+	// these fail in a repository without commits
+	if output, err := runGit(root, "rev-parse", "--abbrev-ref", "HEAD"); err == nil {
+		result.Branch = strings.TrimSpace(string(output))
+	}
+	if output, err := runGit(root, "rev-parse", "HEAD"); err == nil {
+		result.Head = strings.TrimSpace(string(output))
+	}
+	result.File, err = gitStatus(root)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+/*
+Sets the git status of the entries of a directory listing: the status of
+changed files, and whether directories contain changes. Directories which
+aren't in a git repository are left alone.
+*/
+func addGitStatus(dir string, entries []*protocol.DirEntry) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return
+	}
+	root, err := gitRoot(dir)
+	if err != nil {
+		return
+	}
+	files, err := gitStatus(dir, ".")
+	if err != nil {
+		return
+	}
+	// git reports paths in the repository's real location
+	if real, err := filepath.EvalSymlinks(dir); err == nil {
+		dir = real
+	}
+	byName := map[string]*protocol.DirEntry{}
+	for _, entry := range entries {
+		byName[entry.Name] = entry
+	}
+	for _, file := range files {
+		rel, err := filepath.Rel(dir, filepath.Join(root, strings.TrimSuffix(file.Path, "/")))
+		if err != nil || rel == "." || outsideDir(rel) {
+			continue
+		}
+		parts := strings.SplitN(filepath.ToSlash(rel), "/", 2)
+		entry := byName[parts[0]]
+		if entry == nil {
+			continue
+		}
+		if len(parts) == 1 {
+			entry.GitStatus = file.Index + file.WorkingTree
+			// e.g. an untracked directory
+			entry.GitChanges = entry.IsDir
+		} else {
+			entry.GitChanges = true
+		}
+	}
+}
+
+/* returns the merge base of HEAD and the target branch, which may only have been fetched as a remote branch */
+func gitMergeBase(root string, target string) (string, error) {
+	// it would be taken as an option
+	if strings.HasPrefix(target, "-") {
+		return "", errors.Errorf("invalid target branch %q", target)
+	}
+	for _, ref := range []string{target, "origin/" + target} {
+		output, err := runGit(root, "merge-base", "HEAD", ref)
+		if err == nil {
+			return strings.TrimSpace(string(output)), nil
+		}
+	}
+	return "", errors.Errorf("no merge base of HEAD and %v was found, the checkout may be shallow or not have fetched it", target)
+}
+
+func (client *Client) gitDiff(msg *protocol.GitDiff, maxSize int) (*protocol.GitDiffResult, error) {
+	dir, err := gitDir(msg.GetPath())
+	if err != nil {
+		return nil, err
+	}
+	root, err := gitRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+	contextLines := msg.GetContextLines()
+	if contextLines == 0 {
+		contextLines = defaultDiffContextLines
+	}
+	args := []string{"diff", "--no-color", "--no-ext-diff", fmt.Sprintf("-U%v", contextLines)}
+	result := &protocol.GitDiffResult{}
+	if msg.GetBase() == protocol.GitDiff_MERGE_BASE {
+		target := msg.GetTarget()
+		if target == "" {
+			target = pullRequestTargetBranch()
+		}
+		if target == "" {
+			return nil, errors.New("no target branch was given, and none was found in the CI environment")
+		}
+		result.BaseCommit, err = gitMergeBase(root, target)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, result.BaseCommit, "HEAD")
+	} else {
+		output, err := runGit(root, "rev-parse", "HEAD")
+		if err != nil {
+			return nil, err
+		}
+		result.BaseCommit = strings.TrimSpace(string(output))
+		args = append(args, "HEAD")
+	}
+	if msg.GetPath() != "" {
+		path, err := filepath.Abs(msg.GetPath())
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, "--", path)
+	}
+	output, err := runGit(root, args...)
+	if err != nil {
+		return nil, err
+	}
+	// secrets cut off by truncating wouldn't be found
+	output = client.redactor.redact(output)
+	if len(output) > maxSize {
+		output = output[:maxSize]
+		result.Truncated = true
+	}
+	result.Diff = strings.ToValidUTF8(string(output), "�")
+	return result, nil
+}
+
+/* parses the output of `git blame --porcelain`, where commits are only described the first time they appear */
+func parseGitBlame(output []byte) []*protocol.GitBlameLine {
+	var lines []*protocol.GitBlameLine
+	commits := map[string]*protocol.GitBlameLine{}
+	var current *protocol.GitBlameLine
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxGrepScanLine)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if current == nil {
+			fields := strings.Fields(line)
+			if len(fields) < 3 {
+				continue
+			}
+			lineNumber, _ := strconv.Atoi(fields[2])
+			current = &protocol.GitBlameLine{Commit: fields[0], LineNumber: uint32(lineNumber)}
+			if commits[current.Commit] == nil {
+				commits[current.Commit] = &protocol.GitBlameLine{}
+			}
+			continue
+		}
+		commit := commits[current.Commit]
+		if strings.HasPrefix(line, "\t") {
+			current.Line = line[1:]
+			current.Author = commit.Author
+			current.AuthorEmail = commit.AuthorEmail
+			current.AuthorTime = commit.AuthorTime
+			current.Summary = commit.Summary
+			lines = append(lines, current)
+			current = nil
+			continue
+		}
+		parts := strings.SplitN(line, " ", 2)
+		if len(parts) < 2 {
+			continue
+		}
+		switch parts[0] {
+		case "author":
+			commit.Author = parts[1]
+		case "author-mail":
+			commit.AuthorEmail = strings.Trim(parts[1], "<>")
+		case "author-time":
+			commit.AuthorTime, _ = strconv.ParseInt(parts[1], 10, 64)
+		case "summary":
+			commit.Summary = parts[1]
+		}
+	}
+	return lines
+}
+
+func (client *Client) gitBlame(msg *protocol.GitBlame) (*protocol.GitBlameResult, error) {
+	if msg.GetPath() == "" {
+		return nil, errors.New("no path given")
+	}
+	dir, err := gitDir(msg.GetPath())
+	if err != nil {
+		return nil, err
+	}
+	path, err := filepath.Abs(msg.GetPath())
+	if err != nil {
+		return nil, err
+	}
+	args := []string{"blame", "--porcelain"}
+	if msg.GetStartLine() > 0 || msg.GetEndLine() > 0 {
+		lines := fmt.Sprintf("%v,", msg.GetStartLine())
+		if msg.GetStartLine() == 0 {
+			lines = "1,"
+		}
+		if msg.GetEndLine() > 0 {
+			lines += fmt.Sprint(msg.GetEndLine())
+		}
+		args = append(args, "-L", lines)
+	}
+	output, err := runGit(dir, append(args, "--", path)...)
+	if err != nil {
+		return nil, err
+	}
+	result := &protocol.GitBlameResult{
+		Path: msg.GetPath(),
+		Line: parseGitBlame(output),
+	}
+	for _, line := range result.Line {
+		line.Line = strings.ToValidUTF8(string(client.redactor.redact([]byte(line.Line))), "�")
+		line.Summary = strings.ToValidUTF8(line.Summary, "�")
+		line.Author = strings.ToValidUTF8(line.Author, "�")
+	}
+	return result, nil
+}
+
+func (client *Client) handleGitStatus(msg *protocol.GitStatus, listenerId uint32) error {
+	go func() {
+		result, err := client.gitStatus(msg)
+		if err != nil {
+			result = &protocol.GitStatusResult{Error: err.Error()}
+		}
+		result.RequestId = msg.GetRequestId()
+		client.sendGitResult(&protocol.MessageFromWrapClient{
+			Spec:       &protocol.MessageFromWrapClient_GitStatusResult{GitStatusResult: result},
+			ListenerId: listenerId,
+		})
+	}()
+	return nil
+}
+
+func (client *Client) handleGitDiff(msg *protocol.GitDiff, listenerId uint32) error {
+	go func() {
+		result, err := client.gitDiff(msg, maxFileReadSize)
+		if err != nil {
+			result = &protocol.GitDiffResult{Error: err.Error()}
+		}
+		result.RequestId = msg.GetRequestId()
+		client.sendGitResult(&protocol.MessageFromWrapClient{
+			Spec:       &protocol.MessageFromWrapClient_GitDiffResult{GitDiffResult: result},
+			ListenerId: listenerId,
+		})
+	}()
+	return nil
+}
+
+func (client *Client) handleGitBlame(msg *protocol.GitBlame, listenerId uint32) error {
+	go func() {
+		result, err := client.gitBlame(msg)
+		if err != nil {
+			result = &protocol.GitBlameResult{Path: msg.GetPath(), Error: err.Error()}
+		}
+		result.RequestId = msg.GetRequestId()
+		client.sendGitResult(&protocol.MessageFromWrapClient{
+			Spec:       &protocol.MessageFromWrapClient_GitBlameResult{GitBlameResult: result},
+			ListenerId: listenerId,
+		})
+	}()
+	return nil
+}
+
+/*
+Sends the result of a git command. Git commands run in their own goroutines,
+as they can take a while in big checkouts and other messages shouldn't wait.
+*/
+func (client *Client) sendGitResult(msg *protocol.MessageFromWrapClient) {
+	err := client.send(msg)
+	if err != nil {
+		client.debugLog(errors.Wrap(err, "send git result").Error())
+	}
+}
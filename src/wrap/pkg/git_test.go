@@ -0,0 +1,135 @@
+package wrap
+
+import (
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func makeTempGitRepo(t *testing.T) string {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git isn't installed")
+	}
+	dir, err := ioutil.TempDir("", "Wrap.TestGit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertNil(t, "Mkdir", os.Mkdir(filepath.Join(dir, "sub"), 0755))
+	assertNil(t, "WriteFile", ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("one\ntwo\n"), 0644))
+	assertNil(t, "WriteFile", ioutil.WriteFile(filepath.Join(dir, "sub", "b.txt"), []byte("b\n"), 0644))
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "."},
+		{"-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "Initial commit"},
+	} {
+		if _, err := runGit(dir, args...); err != nil {
+			os.RemoveAll(dir)
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestParseGitStatus(t *testing.T) {
+	files := parseGitStatus([]byte(" M a.txt\x00R  new.txt\x00old.txt\x00?? sub/\x00"))
+	assertEqual(t, "len", 3, len(files))
+	assertEqual(t, "WorkingTree", "M", files[0].WorkingTree)
+	assertEqual(t, "Path", "new.txt", files[1].Path)
+	assertEqual(t, "OriginalPath", "old.txt", files[1].OriginalPath)
+	assertEqual(t, "Index", "?", files[2].Index)
+	assertEqual(t, "Path", "sub/", files[2].Path)
+}
+
+func TestGitStatusAndDiff(t *testing.T) {
+	dir := makeTempGitRepo(t)
+	defer os.RemoveAll(dir)
+	c := newBlankTestClient()
+	assertNil(t, "WriteFile", ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("one\nthree\n"), 0644))
+	assertNil(t, "WriteFile", ioutil.WriteFile(filepath.Join(dir, "sub", "c.txt"), []byte("c\n"), 0644))
+
+	status, err := c.gitStatus(&protocol.GitStatus{Path: dir})
+	assertNil(t, "err", err)
+	assertEqual(t, "len", 2, len(status.File))
+	assertEqual(t, "Path", "a.txt", status.File[0].Path)
+	assertEqual(t, "WorkingTree", "M", status.File[0].WorkingTree)
+	assertEqual(t, "Path", "sub/c.txt", status.File[1].Path)
+	assertEqual(t, "Head", 40, len(status.Head))
+
+	diff, err := c.gitDiff(&protocol.GitDiff{Path: filepath.Join(dir, "a.txt")}, maxFileReadSize)
+	assertNil(t, "err", err)
+	assertEqual(t, "BaseCommit", status.Head, diff.BaseCommit)
+	assertEqual(t, "has removed line", true, strings.Contains(diff.Diff, "\n-two\n"))
+	assertEqual(t, "has added line", true, strings.Contains(diff.Diff, "\n+three\n"))
+
+	_, err = c.gitDiff(&protocol.GitDiff{Path: dir, Base: protocol.GitDiff_MERGE_BASE, Target: "--output=x"}, maxFileReadSize)
+	assertEqual(t, "option target error", "invalid target branch \"--output=x\"", err.Error())
+
+	// a secret cut off by the size limit is still masked
+	assertNil(t, "WriteFile", ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("one\nhunter22\n"), 0644))
+	diff, err = c.gitDiff(&protocol.GitDiff{Path: dir}, maxFileReadSize)
+	assertNil(t, "err", err)
+	c.redactor = newRedactor([]string{"hunter22"}, nil)
+	diff, err = c.gitDiff(&protocol.GitDiff{Path: dir}, strings.Index(diff.Diff, "hunter22")+4)
+	assertNil(t, "err", err)
+	assertEqual(t, "Truncated", true, diff.Truncated)
+	assertEqual(t, "has secret", false, strings.Contains(diff.Diff, "hunt"))
+	c.redactor = nil
+
+	// not mistaken for a path outside the directory
+	assertNil(t, "WriteFile", ioutil.WriteFile(filepath.Join(dir, "..d.txt"), []byte("d\n"), 0644))
+	dirResult, err := c.readFileDir(&protocol.FileReadDir{Path: dir})
+	assertNil(t, "err", err)
+	for _, entry := range dirResult.Entry {
+		switch entry.Name {
+		case "a.txt":
+			assertEqual(t, "GitStatus", " M", entry.GitStatus)
+		case "sub":
+			assertEqual(t, "GitChanges", true, entry.GitChanges)
+		case "..d.txt":
+			assertEqual(t, "GitStatus", "??", entry.GitStatus)
+		}
+	}
+}
+
+func TestGitBlame(t *testing.T) {
+	dir := makeTempGitRepo(t)
+	defer os.RemoveAll(dir)
+	c := newBlankTestClient()
+	result, err := c.gitBlame(&protocol.GitBlame{Path: filepath.Join(dir, "a.txt"), StartLine: 2})
+	assertNil(t, "err", err)
+	assertEqual(t, "len", 1, len(result.Line))
+	assertEqual(t, "LineNumber", uint32(2), result.Line[0].LineNumber)
+	assertEqual(t, "Line", "two", result.Line[0].Line)
+	assertEqual(t, "Author", "Test", result.Line[0].Author)
+	assertEqual(t, "AuthorEmail", "test@example.com", result.Line[0].AuthorEmail)
+	assertEqual(t, "Summary", "Initial commit", result.Line[0].Summary)
+}
+
+func TestGitHandlersReply(t *testing.T) {
+	dir := makeTempGitRepo(t)
+	defer os.RemoveAll(dir)
+	c := newBlankTestClient()
+	messages, closeClient := connectTestClient(t, c)
+	defer closeClient()
+	// git runs in the background, the handlers return straight away
+	assertNil(t, "status error", c.handleGitStatus(&protocol.GitStatus{RequestId: 1, Path: dir}, 0))
+	assertNil(t, "read dir error", c.handleFileReadDir(&protocol.FileReadDir{Path: dir}, 0))
+	var status *protocol.GitStatusResult
+	var listing *protocol.FileReadDirResult
+	waitForMessage(t, messages, func(msg *protocol.MessageFromWrapClient) bool {
+		if msg.GetGitStatusResult() != nil {
+			status = msg.GetGitStatusResult()
+		}
+		if msg.GetFileReadDirResult() != nil {
+			listing = msg.GetFileReadDirResult()
+		}
+		return status != nil && listing != nil
+	})
+	assertEqual(t, "RequestId", uint32(1), status.RequestId)
+	assertEqual(t, "Head", 40, len(status.Head))
+	assertEqual(t, "entries", 3, len(listing.Entry))
+}
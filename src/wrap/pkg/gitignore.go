@@ -0,0 +1,166 @@
+package wrap
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+/*
+Converts a glob to a regular expression matching whole slash-separated
+paths. * and ? don't match slashes, ** matches any number of directories,
+like in .gitignore files.
+*/
+func globRegexp(glob string) (*regexp.Regexp, error) {
+	var re strings.Builder
+	re.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			re.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "/**") && i+3 == len(glob):
+			re.WriteString("/.*")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			re.WriteString(".*")
+			i++
+		case c == '*':
+			re.WriteString("[^/]*")
+		case c == '?':
+			re.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				re.WriteString(`\[`)
+				continue
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			re.WriteString("[" + strings.Replace(class, `\`, `\\`, -1) + "]")
+			i += end + 1
+		case c == '\\' && i+1 < len(glob):
+			i++
+			re.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		default:
+			re.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	re.WriteString("$")
+	return regexp.Compile(re.String())
+}
+
+type ignoreRule struct {
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+/* the rules of a .gitignore file, which apply to the paths below its directory */
+type ignoreFile struct {
+	dir   string
+	rules []ignoreRule
+}
+
+/* parses the .gitignore file in dir, returning nil if there isn't one */
+func readIgnoreFile(dir string) *ignoreFile {
+	f, err := os.Open(filepath.Join(dir, ".gitignore"))
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	ignore := &ignoreFile{dir: dir}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rule := ignoreRule{}
+		if strings.HasPrefix(line, "!") {
+			rule.negate = true
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			rule.dirOnly = true
+			line = strings.TrimRight(line, "/")
+		}
+		// patterns without a slash match at any depth, others are relative to the .gitignore file
+		if strings.Contains(line, "/") {
+			line = strings.TrimPrefix(line, "/")
+		} else {
+			line = "**/" + line
+		}
+		re, err := globRegexp(line)
+		if err != nil {
+			continue
+		}
+		rule.re = re
+		ignore.rules = append(ignore.rules, rule)
+	}
+	return ignore
+}
+
+/*
+Returns whether a path is ignored by the given .gitignore files, which are
+ordered from the outermost directory in. Later rules override earlier ones.
+*/
+func isIgnored(ignores []*ignoreFile, path string, isDir bool) bool {
+	ignored := false
+	for _, ignore := range ignores {
+		rel, err := filepath.Rel(ignore.dir, path)
+		if err != nil || outsideDir(rel) {
+			continue
+		}
+		rel = filepath.ToSlash(rel)
+		for _, rule := range ignore.rules {
+			if rule.dirOnly && !isDir {
+				continue
+			}
+			if rule.re.MatchString(rel) {
+				ignored = !rule.negate
+			}
+		}
+	}
+	return ignored
+}
+
+/* returns whether a path relative to a directory, as from filepath.Rel, is outside it */
+func outsideDir(rel string) bool {
+	return rel == ".." || strings.HasPrefix(rel, "../")
+}
+
+/*
+Returns the .gitignore files which apply to a directory, from the root of
+its git repository down, or none if it's not in one. The directory has to be
+absolute, both to find the repository and for isIgnored.
+*/
+func parentIgnoreFiles(dir string) []*ignoreFile {
+	var dirs []string
+	found := false
+	for d := dir; ; d = filepath.Dir(d) {
+		dirs = append(dirs, d)
+		if _, err := os.Stat(filepath.Join(d, ".git")); err == nil {
+			found = true
+			break
+		}
+		if filepath.Dir(d) == d {
+			break
+		}
+	}
+	if !found {
+		dirs = dirs[:1]
+	}
+	var ignores []*ignoreFile
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if ignore := readIgnoreFile(dirs[i]); ignore != nil {
+			ignores = append(ignores, ignore)
+		}
+	}
+	return ignores
+}
@@ -3,6 +3,7 @@ package wrap
 import (
 	"crypto/tls"
 	"fmt"
+	"github.com/golang/protobuf/proto"
 	"github.com/layer-devops/wrap.sh/src/protocol"
 	"github.com/pkg/errors"
 	"io"
@@ -155,6 +156,8 @@ func (client *Client) sendHello() error {
 		workingDirectory = "/"
 	}
 	msg.WorkingDirectory = workingDirectory
+	// lets the dashboard hide what it can't use
+	msg.Access = client.access()
 	client.debugLog("Getting pipeline info...")
 	errs := populatePipelineInfo(msg)
 	if len(errs) > 0 {
@@ -192,6 +195,7 @@ func (client *Client) sendHello() error {
 		}
 	}
 	msg.Service = services
+	client.hello = msg
 	return client.send(&protocol.MessageFromWrapClient{
 		Spec: &protocol.MessageFromWrapClient_Hello{
 			Hello: msg,
@@ -199,11 +203,42 @@ func (client *Client) sendHello() error {
 	})
 }
 
+/* keeps the session id and resume token the server assigned */
+func (client *Client) setSession(sessionId string, resumeToken string) {
+	client.sessionMutex.Lock()
+	defer client.sessionMutex.Unlock()
+	client.UUID = sessionId
+	client.resumeToken = resumeToken
+}
+
+/* returns the session id and resume token to present when reconnecting */
+func (client *Client) session() (string, string) {
+	client.sessionMutex.Lock()
+	defer client.sessionMutex.Unlock()
+	return client.UUID, client.resumeToken
+}
+
+/* returns the hello sent when reconnecting, which skips gathering pipeline info again */
+func (client *Client) resumeHello() *protocol.MessageFromWrapClient {
+	msg := proto.Clone(client.hello).(*protocol.Hello)
+	msg.SessionId, msg.ResumeToken = client.session()
+	return &protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_Hello{
+			Hello: msg,
+		},
+	}
+}
+
 func (client *Client) handleHelloResponse(msg *protocol.HelloResponse) error {
 	dashboardUrl := msg.GetDashboardUrl()
 	if dashboardUrl == "" {
 		return errors.New("unable to request debug server")
 	}
+	// the session was kept when the response was read, see listenServer
+	if dashboardUrl == client.DashboardURL {
+		client.Log("Reconnected to the debug server.")
+		return nil
+	}
 	client.DashboardURL = dashboardUrl
 	client.Log("Debug server ready! Explore and make changes at:")
 	client.Log(client.DashboardURL)
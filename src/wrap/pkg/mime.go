@@ -0,0 +1,235 @@
+package wrap
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// how much of a file is looked at to detect its type
+const mimeSniffLength = 512
+
+// types are reported like `file -i` does, e.g. "text/plain; charset=us-ascii"
+const binaryCharset = "binary"
+
+type magic struct {
+	offset   int
+	prefix   []byte
+	mimeType string
+}
+
+// checked in order, the first match wins
+var magics = []magic{
+	{0, []byte("\x89PNG\r\n\x1a\n"), "image/png"},
+	{0, []byte("\xff\xd8\xff"), "image/jpeg"},
+	{0, []byte("GIF87a"), "image/gif"},
+	{0, []byte("GIF89a"), "image/gif"},
+	{0, []byte("\x00\x00\x01\x00"), "image/vnd.microsoft.icon"},
+	{0, []byte("%PDF-"), "application/pdf"},
+	{0, []byte("PK\x03\x04"), "application/zip"},
+	{0, []byte("PK\x05\x06"), "application/zip"},
+	{0, []byte("\x1f\x8b"), "application/gzip"},
+	{0, []byte("BZh"), "application/x-bzip2"},
+	{0, []byte("\xfd7zXZ\x00"), "application/x-xz"},
+	{0, []byte("\x28\xb5\x2f\xfd"), "application/zstd"},
+	{0, []byte("7z\xbc\xaf\x27\x1c"), "application/x-7z-compressed"},
+	{257, []byte("ustar"), "application/x-tar"},
+	{0, []byte("\x7fELF"), "application/x-executable"},
+	{0, []byte("\xcf\xfa\xed\xfe"), "application/x-mach-binary"},
+	{0, []byte("\xca\xfe\xba\xbe"), "application/x-java-applet"},
+	{0, []byte("\x00asm"), "application/wasm"},
+	{0, []byte("SQLite format 3\x00"), "application/vnd.sqlite3"},
+	{0, []byte("wOFF"), "font/woff"},
+	{0, []byte("wOF2"), "font/woff2"},
+	{0, []byte("OggS"), "audio/ogg"},
+	{0, []byte("ID3"), "audio/mpeg"},
+	{0, []byte("fLaC"), "audio/flac"},
+	{0, []byte("\x1a\x45\xdf\xa3"), "video/webm"},
+}
+
+// types of text files, by extension. Other text files are text/plain
+var textMimeTypes = map[string]string{
+	".c":    "text/x-c",
+	".cc":   "text/x-c++",
+	".cpp":  "text/x-c++",
+	".css":  "text/css",
+	".csv":  "text/csv",
+	".go":   "text/x-go",
+	".h":    "text/x-c",
+	".htm":  "text/html",
+	".html": "text/html",
+	".java": "text/x-java",
+	".js":   "application/javascript",
+	".json": "application/json",
+	".jsx":  "application/javascript",
+	".md":   "text/markdown",
+	".php":  "text/x-php",
+	".py":   "text/x-python",
+	".rb":   "text/x-ruby",
+	".rs":   "text/x-rust",
+	".sh":   "text/x-shellscript",
+	".svg":  "image/svg+xml",
+	".ts":   "application/typescript",
+	".tsx":  "application/typescript",
+	".xml":  "text/xml",
+	".yaml": "text/x-yaml",
+	".yml":  "text/x-yaml",
+}
+
+// types of binary files without a recognizable header, by extension
+var binaryMimeTypes = map[string]string{
+	".bmp":  "image/bmp",
+	".mp4":  "video/mp4",
+	".webp": "image/webp",
+	".ttf":  "font/ttf",
+	".otf":  "font/otf",
+	".mov":  "video/quicktime",
+	".jar":  "application/java-archive",
+}
+
+// interpreters named in shebang lines
+var scriptMimeTypes = map[string]string{
+	"sh":     "text/x-shellscript",
+	"bash":   "text/x-shellscript",
+	"zsh":    "text/x-shellscript",
+	"python": "text/x-python",
+	"node":   "application/javascript",
+	"ruby":   "text/x-ruby",
+	"perl":   "text/x-perl",
+}
+
+/* returns the interpreter a script's shebang line runs, e.g. "python" for "#!/usr/bin/env python3" */
+func shebangInterpreter(head []byte) string {
+	if !bytes.HasPrefix(head, []byte("#!")) {
+		return ""
+	}
+	line := string(head[2:])
+	if i := strings.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i]
+	}
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return ""
+	}
+	interpreter := filepath.Base(fields[0])
+	if interpreter == "env" && len(fields) > 1 {
+		interpreter = fields[1]
+	}
+	// e.g. python3
+	return strings.TrimRight(interpreter, "0123456789.")
+}
+
+/*
+Returns the charset of text, or "binary". The head may have been cut in the
+middle of a character if it's not the whole file.
+*/
+func textCharset(head []byte, whole bool) string {
+	if bytes.IndexByte(head, 0) >= 0 {
+		return binaryCharset
+	}
+	ascii := true
+	for _, b := range head {
+		if b >= 0x80 {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return "us-ascii"
+	}
+	if !whole {
+		// drop a character cut off at the end
+		for i := 1; i <= utf8.UTFMax && i <= len(head); i++ {
+			if utf8.RuneStart(head[len(head)-i]) {
+				if !utf8.FullRune(head[len(head)-i:]) {
+					head = head[:len(head)-i]
+				}
+				break
+			}
+		}
+	}
+	if utf8.Valid(head) {
+		return "utf-8"
+	}
+	controls := 0
+	for _, b := range head {
+		if b < 0x20 && b != '\n' && b != '\r' && b != '\t' && b != '\f' && b != 0x1b {
+			controls++
+		}
+	}
+	if controls > len(head)/10 {
+		return binaryCharset
+	}
+	return "iso-8859-1"
+}
+
+/*
+Returns the type of a file from the start of its contents and its name,
+in the format of `file -i`. The head is the whole file if it's shorter
+than mimeSniffLength.
+*/
+func sniffMimeType(name string, head []byte, whole bool) string {
+	if len(head) == 0 {
+		return "inode/x-empty; charset=binary"
+	}
+	for _, m := range magics {
+		if len(head) >= m.offset+len(m.prefix) && bytes.Equal(head[m.offset:m.offset+len(m.prefix)], m.prefix) {
+			return m.mimeType + "; charset=binary"
+		}
+	}
+	ext := strings.ToLower(filepath.Ext(name))
+	charset := textCharset(head, whole)
+	if charset == binaryCharset {
+		if mimeType, ok := binaryMimeTypes[ext]; ok {
+			return mimeType + "; charset=binary"
+		}
+		return "application/octet-stream; charset=binary"
+	}
+	mimeType := "text/plain"
+	if t, ok := scriptMimeTypes[shebangInterpreter(head)]; ok {
+		mimeType = t
+	} else if t, ok := textMimeTypes[ext]; ok {
+		mimeType = t
+	} else if trimmed := bytes.TrimSpace(head); bytes.HasPrefix(trimmed, []byte("<?xml")) {
+		mimeType = "text/xml"
+	} else if bytes.HasPrefix(bytes.ToLower(trimmed), []byte("<!doctype html")) {
+		mimeType = "text/html"
+	}
+	return mimeType + "; charset=" + charset
+}
+
+/* returns the type of the file at path, with the given info from Lstat, without reading it if it's not a regular file */
+func detectMimeType(path string, info os.FileInfo) string {
+	switch {
+	case info.IsDir():
+		return "inode/directory; charset=binary"
+	case info.Mode()&os.ModeSymlink != 0:
+		return "inode/symlink; charset=binary"
+	case info.Mode()&os.ModeNamedPipe != 0:
+		return "inode/fifo; charset=binary"
+	case info.Mode()&os.ModeSocket != 0:
+		return "inode/socket; charset=binary"
+	case info.Mode()&os.ModeCharDevice != 0:
+		return "inode/chardevice; charset=binary"
+	case info.Mode()&os.ModeDevice != 0:
+		return "inode/blockdevice; charset=binary"
+	case !info.Mode().IsRegular():
+		return "application/octet-stream; charset=binary"
+	case info.Size() == 0:
+		return "inode/x-empty; charset=binary"
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	head := make([]byte, mimeSniffLength)
+	n, err := io.ReadFull(f, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return ""
+	}
+	return sniffMimeType(path, head[:n], int64(n) == info.Size())
+}
@@ -0,0 +1,23 @@
+package wrap
+
+import "testing"
+
+func TestSniffMimeType(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		head     string
+		expected string
+	}{
+		{"a.txt", "abc", "text/plain; charset=us-ascii"},
+		{"a.txt", "h\xc3\xa9", "text/plain; charset=utf-8"},
+		{"a.json", "{}", "application/json; charset=us-ascii"},
+		{"run", "#!/usr/bin/env python3\n", "text/x-python; charset=us-ascii"},
+		{"logo", "\x89PNG\r\n\x1a\n....", "image/png; charset=binary"},
+		{"data", "\x01\x00\x02", "application/octet-stream; charset=binary"},
+		{"empty", "", "inode/x-empty; charset=binary"},
+	} {
+		assertEqual(t, "type of "+c.name, c.expected, sniffMimeType(c.name, []byte(c.head), true))
+	}
+	// the head was cut in the middle of a character
+	assertEqual(t, "cut head", "text/plain; charset=utf-8", sniffMimeType("a", []byte("h\xc3\xa9\xc3"), false))
+}
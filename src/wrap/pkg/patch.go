@@ -0,0 +1,318 @@
+package wrap
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const defaultPatchMessage = "Changes from the wrap.sh debug session"
+
+/* records files edited from the dashboard, so that the ones git doesn't track can be pointed out in exported patches */
+func (client *Client) trackEdit(paths ...string) {
+	client.editedPathsMutex.Lock()
+	defer client.editedPathsMutex.Unlock()
+	if client.editedPaths == nil {
+		client.editedPaths = map[string]bool{}
+	}
+	for _, path := range paths {
+		if abs, err := filepath.Abs(path); err == nil {
+			client.editedPaths[abs] = true
+		}
+	}
+}
+
+func (client *Client) trackedEdits() []string {
+	client.editedPathsMutex.Lock()
+	defer client.editedPathsMutex.Unlock()
+	var paths []string
+	for path := range client.editedPaths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+/* the commit the pipeline started from, or HEAD if it isn't known or isn't in the checkout */
+func (client *Client) patchBaseCommit(root string) (string, error) {
+	if client.hello != nil && client.hello.CommitHash != "" {
+		output, err := runGit(root, "rev-parse", "--verify", "--quiet", client.hello.CommitHash+"^{commit}")
+		if err == nil {
+			return strings.TrimSpace(string(output)), nil
+		}
+		client.debugLog("commit %v isn't in the checkout, exporting the changes since HEAD", client.hello.CommitHash)
+	}
+	output, err := runGit(root, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+/*
+Returns the untracked files which were changed during the debug session, or
+edited from the dashboard, relative to the repository's root. Other untracked
+files, such as the pipeline's build output, are left out of patches, rather
+than hashed into the repository.
+*/
+func (client *Client) untrackedChanges(root string) ([]string, error) {
+	output, err := runGit(root, "ls-files", "--others", "--exclude-standard", "--full-name", "-z")
+	if err != nil {
+		return nil, err
+	}
+	edited := map[string]bool{}
+	for _, path := range client.trackedEdits() {
+		if rel, err := filepath.Rel(root, realPath(path)); err == nil {
+			edited[filepath.ToSlash(rel)] = true
+		}
+	}
+	client.debugSessionMutex.Lock()
+	since := client.debugSessionStart
+	client.debugSessionMutex.Unlock()
+	var changed []string
+	for _, rel := range strings.Split(string(output), "\x00") {
+		if rel == "" {
+			continue
+		}
+		info, err := os.Lstat(filepath.Join(root, rel))
+		if err != nil {
+			continue
+		}
+		if edited[rel] || !info.ModTime().Before(since) {
+			changed = append(changed, rel)
+		}
+	}
+	return changed, nil
+}
+
+/*
+Stages the changes to tracked files, and the untracked files changed during
+the debug session, in a copy of the repository's index, so that the index
+itself is left alone. Returns the path of the copy, which the caller removes.
+*/
+func (client *Client) stageWorkingTree(root string) (string, error) {
+	output, err := runGit(root, "rev-parse", "--git-path", "index")
+	if err != nil {
+		return "", err
+	}
+	indexPath := strings.TrimSpace(string(output))
+	if !filepath.IsAbs(indexPath) {
+		indexPath = filepath.Join(root, indexPath)
+	}
+	tmp, err := ioutil.TempFile("", "wrap-index-")
+	if err != nil {
+		return "", errors.Wrap(err, "create")
+	}
+	index, err := os.Open(indexPath)
+	if err == nil {
+		// the copy keeps the stat info of unchanged files, so they aren't hashed again
+		_, err = io.Copy(tmp, index)
+		_ = index.Close()
+	}
+	_ = tmp.Close()
+	if os.IsNotExist(err) {
+		// a repository without commits may not have an index, git creates it
+		err = os.Remove(tmp.Name())
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return "", errors.Wrap(err, "copy index")
+	}
+	env := []string{"GIT_INDEX_FILE=" + tmp.Name()}
+	untracked, err := client.untrackedChanges(root)
+	if err == nil {
+		_, err = runGitWithEnv(root, env, "add", "--update")
+	}
+	// in batches, to stay within the limits on arguments
+	for len(untracked) > 0 && err == nil {
+		batch := untracked
+		if len(batch) > 1000 {
+			batch = batch[:1000]
+		}
+		untracked = untracked[len(batch):]
+		_, err = runGitWithEnv(root, append(env, "GIT_LITERAL_PATHSPECS=1"), append([]string{"add", "--"}, batch...)...)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return "", err
+	}
+	return tmp.Name(), nil
+}
+
+/* parses the output of `git diff --name-status -z --no-renames` */
+func parseNameStatus(output []byte) []*protocol.GitFileStatus {
+	var files []*protocol.GitFileStatus
+	fields := strings.Split(strings.TrimSuffix(string(output), "\x00"), "\x00")
+	for i := 0; i+1 < len(fields); i += 2 {
+		files = append(files, &protocol.GitFileStatus{
+			Index: fields[i],
+			Path:  fields[i+1],
+		})
+	}
+	return files
+}
+
+/* the identity to commit as for format-patch: the pipeline commit's author, or git's configured identity */
+func (client *Client) patchIdentity(root string) []string {
+	name, email := "", ""
+	if client.hello != nil {
+		name, email = client.hello.AuthorName, client.hello.AuthorEmail
+	}
+	if name == "" || email == "" {
+		if _, err := runGit(root, "var", "GIT_AUTHOR_IDENT"); err == nil {
+			return nil
+		}
+		name, email = "wrap.sh", "noreply@wrap.sh"
+	}
+	return []string{
+		"GIT_AUTHOR_NAME=" + name,
+		"GIT_AUTHOR_EMAIL=" + email,
+		"GIT_COMMITTER_NAME=" + name,
+		"GIT_COMMITTER_EMAIL=" + email,
+	}
+}
+
+/* returns the path with symlinks in its directory resolved, as git reports it, even if the file was deleted */
+func realPath(path string) string {
+	dir, err := filepath.EvalSymlinks(filepath.Dir(path))
+	if err != nil {
+		return path
+	}
+	return filepath.Join(dir, filepath.Base(path))
+}
+
+/* returns the files edited from the dashboard which are outside the repository, or ignored by git */
+func (client *Client) untrackedEdits(root string) []string {
+	var untracked []string
+	for _, path := range client.trackedEdits() {
+		rel, err := filepath.Rel(root, realPath(path))
+		if err != nil || outsideDir(rel) {
+			untracked = append(untracked, path)
+			continue
+		}
+		if _, err := runGit(root, "check-ignore", "--quiet", "--", rel); err == nil {
+			untracked = append(untracked, path)
+		}
+	}
+	return untracked
+}
+
+/*
+Returns the changes made in the checkout since the pipeline's commit, by
+the dashboard or in terminals, as a patch to apply to that commit. Untracked
+files are only included if they were changed then, see untrackedChanges. Staging
+the changes and committing them for format-patch adds objects to the
+repository, though its index and branches are left alone. Patches which
+would be cut off, or which contain secrets, aren't exported: a patch with
+either wouldn't apply, or would write the mask over the secrets.
+*/
+func (client *Client) exportPatch(msg *protocol.ExportPatch, maxSize int) (*protocol.ExportPatchResult, error) {
+	var pathspec []string
+	for _, path := range msg.GetPath() {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return nil, err
+		}
+		pathspec = append(pathspec, realPath(abs))
+	}
+	dir := ""
+	if len(pathspec) > 0 {
+		dir = pathspec[0]
+		if _, err := os.Stat(dir); err != nil {
+			// e.g. a deleted file
+			dir = filepath.Dir(dir)
+		}
+	}
+	dir, err := gitDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	root, err := gitRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+	base, err := client.patchBaseCommit(root)
+	if err != nil {
+		return nil, err
+	}
+	index, err := client.stageWorkingTree(root)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(index)
+	env := []string{"GIT_INDEX_FILE=" + index}
+	pathspec = append([]string{"--"}, pathspec...)
+
+	result := &protocol.ExportPatchResult{
+		BaseCommit:    base,
+		UntrackedEdit: client.untrackedEdits(root),
+	}
+	output, err := runGitWithEnv(root, env, append([]string{"diff", "--cached", "--name-status", "--no-renames", "-z", base}, pathspec...)...)
+	if err != nil {
+		return nil, err
+	}
+	result.File = parseNameStatus(output)
+	if len(result.File) == 0 {
+		return result, nil
+	}
+
+	var patch []byte
+	if msg.GetFormat() == protocol.ExportPatch_FORMAT_PATCH {
+		output, err := runGitWithEnv(root, env, "write-tree")
+		if err != nil {
+			return nil, err
+		}
+		message := msg.GetMessage()
+		if message == "" {
+			message = defaultPatchMessage
+		}
+		// the commit isn't on any branch, the checkout is left as it was
+		output, err = runGitWithEnv(root, client.patchIdentity(root),
+			"commit-tree", strings.TrimSpace(string(output)), "-p", base, "-m", message)
+		if err != nil {
+			return nil, err
+		}
+		commit := strings.TrimSpace(string(output))
+		patch, err = runGit(root, append([]string{"format-patch", "--stdout", "--binary", "-1", commit}, pathspec...)...)
+		if err != nil {
+			return nil, err
+		}
+		result.FileName = fmt.Sprintf("0001-wrap-%.7s.patch", base)
+	} else {
+		patch, err = runGitWithEnv(root, env, append([]string{"diff", "--cached", "--binary", "--no-color", "--no-ext-diff", base}, pathspec...)...)
+		if err != nil {
+			return nil, err
+		}
+		result.FileName = fmt.Sprintf("wrap-%.7s.diff", base)
+	}
+	if len(patch) > maxSize {
+		return nil, errors.Errorf("the patch is bigger than the %v byte limit, export fewer paths", maxSize)
+	}
+	if client.redactor != nil && !bytes.Equal(client.redactor.redact(patch), patch) {
+		return nil, errors.New("the patch contains secrets, which can't be exported")
+	}
+	result.Patch = patch
+	return result, nil
+}
+
+func (client *Client) handleExportPatch(msg *protocol.ExportPatch, listenerId uint32) error {
+	go func() {
+		result, err := client.exportPatch(msg, maxFileReadSize)
+		if err != nil {
+			result = &protocol.ExportPatchResult{Error: err.Error()}
+		}
+		result.RequestId = msg.GetRequestId()
+		client.sendGitResult(&protocol.MessageFromWrapClient{
+			Spec:       &protocol.MessageFromWrapClient_ExportPatchResult{ExportPatchResult: result},
+			ListenerId: listenerId,
+		})
+	}()
+	return nil
+}
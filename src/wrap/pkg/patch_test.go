@@ -0,0 +1,82 @@
+package wrap
+
+import (
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExportPatch(t *testing.T) {
+	dir := makeTempGitRepo(t)
+	defer os.RemoveAll(dir)
+	status, err := newBlankTestClient().gitStatus(&protocol.GitStatus{Path: dir})
+	assertNil(t, "err", err)
+	c := newBlankTestClient()
+	c.hello = &protocol.Hello{CommitHash: status.Head}
+
+	assertNil(t, "WriteFile", ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("one\nthree\n"), 0644))
+	assertNil(t, "WriteFile", ioutil.WriteFile(filepath.Join(dir, "new.txt"), []byte("new\n"), 0644))
+	assertNil(t, "Remove", os.Remove(filepath.Join(dir, "sub", "b.txt")))
+	outside := makeTempFile(t, "outside")
+	defer outside.Remove()
+	// untracked files from before the debug session are left out, unless they were edited from the dashboard
+	c.debugSessionStart = time.Now().Add(-time.Minute)
+	old := time.Now().Add(-time.Hour)
+	for _, name := range []string{"build.out", "config.txt"} {
+		assertNil(t, "WriteFile", ioutil.WriteFile(filepath.Join(dir, name), []byte(name+"\n"), 0644))
+		assertNil(t, "Chtimes", os.Chtimes(filepath.Join(dir, name), old, old))
+	}
+	c.trackEdit(filepath.Join(dir, "a.txt"), filepath.Join(dir, "config.txt"), outside.Path)
+
+	result, err := c.exportPatch(&protocol.ExportPatch{Path: []string{dir}}, maxFileReadSize)
+	assertNil(t, "err", err)
+	assertEqual(t, "BaseCommit", status.Head, result.BaseCommit)
+	assertEqual(t, "len", 4, len(result.File))
+	assertEqual(t, "Path", "a.txt", result.File[0].Path)
+	assertEqual(t, "Path", "config.txt", result.File[1].Path)
+	assertEqual(t, "Path", "new.txt", result.File[2].Path)
+	assertEqual(t, "Index", "A", result.File[2].Index)
+	assertEqual(t, "Index", "D", result.File[3].Index)
+	assertEqual(t, "has added line", true, strings.Contains(string(result.Patch), "\n+three\n"))
+	assertEqual(t, "len", 1, len(result.UntrackedEdit))
+	assertEqual(t, "UntrackedEdit", outside.Path, result.UntrackedEdit[0])
+
+	// the repository's own index is left alone
+	after, err := c.gitStatus(&protocol.GitStatus{Path: dir})
+	assertNil(t, "err", err)
+	for _, file := range after.File {
+		if file.Path == "new.txt" {
+			assertEqual(t, "Index", "?", file.Index)
+		}
+	}
+
+	result, err = c.exportPatch(&protocol.ExportPatch{Format: protocol.ExportPatch_FORMAT_PATCH, Path: []string{dir}, Message: "Fix the test"}, maxFileReadSize)
+	assertNil(t, "err", err)
+	assertEqual(t, "has subject", true, strings.Contains(string(result.Patch), "Subject: [PATCH] Fix the test"))
+
+	// patches which would be cut off or masked aren't exported
+	_, err = c.exportPatch(&protocol.ExportPatch{Path: []string{dir}}, 10)
+	assertEqual(t, "too big error", "the patch is bigger than the 10 byte limit, export fewer paths", err.Error())
+	assertNil(t, "WriteFile", ioutil.WriteFile(filepath.Join(dir, "config.txt"), []byte("token=hunter22\n"), 0644))
+	c.redactor = newRedactor([]string{"hunter22"}, nil)
+	_, err = c.exportPatch(&protocol.ExportPatch{Path: []string{dir}}, maxFileReadSize)
+	assertEqual(t, "secret error", "the patch contains secrets, which can't be exported", err.Error())
+	result, err = c.exportPatch(&protocol.ExportPatch{Path: []string{filepath.Join(dir, "a.txt")}}, maxFileReadSize)
+	assertNil(t, "err", err)
+	assertEqual(t, "len", 1, len(result.File))
+}
+
+func TestUntrackedEditsDotDotName(t *testing.T) {
+	dir := makeTempGitRepo(t)
+	defer os.RemoveAll(dir)
+	root, err := gitRoot(dir)
+	assertNil(t, "err", err)
+	c := newBlankTestClient()
+	// a name starting with .. is still inside the repository
+	c.trackEdit(filepath.Join(dir, "..a.txt"))
+	assertEqual(t, "len", 0, len(c.untrackedEdits(root)))
+}
@@ -0,0 +1,227 @@
+package wrap
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/creack/pty"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// recordings larger than this are kept on disk only
+const maxUploadedRecordingSize = 16 * 1024 * 1024
+
+// asciicast files need a size, this is used if the pty doesn't have one yet
+const (
+	defaultRecordingCols = 80
+	defaultRecordingRows = 24
+)
+
+/*
+Records a terminal session in the asciicast v2 format,
+see https://github.com/asciinema/asciinema/blob/develop/doc/asciicast-v2.md
+*/
+type recording struct {
+	mutex sync.Mutex
+	file  *os.File
+	path  string
+	start time.Time
+	// incomplete UTF-8 sequences at the end of the last input and output
+	pending map[string][]byte
+	err     error
+}
+
+type recordingHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+func newRecording(dir string, name string, title string, shell string, rows int, cols int) (*recording, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, errors.Wrap(err, "create recording directory")
+	}
+	path := filepath.Join(dir, name)
+	// recordings can contain anything typed into the terminal, keep them private
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "create recording")
+	}
+	if rows <= 0 || cols <= 0 {
+		rows, cols = defaultRecordingRows, defaultRecordingCols
+	}
+	r := &recording{
+		file:    file,
+		path:    path,
+		start:   time.Now(),
+		pending: map[string][]byte{},
+	}
+	header, err := json.Marshal(&recordingHeader{
+		Version:   2,
+		Width:     cols,
+		Height:    rows,
+		Timestamp: r.start.Unix(),
+		Title:     title,
+		Env: map[string]string{
+			"SHELL": shell,
+			"TERM":  os.Getenv("TERM"),
+		},
+	})
+	if err == nil {
+		_, err = file.Write(append(header, '\n'))
+	}
+	if err != nil {
+		file.Close()
+		return nil, errors.Wrap(err, "write recording header")
+	}
+	return r, nil
+}
+
+/*
+Appends an event to the recording. Data is held back until it ends
+on a complete UTF-8 sequence, since events are JSON strings.
+*/
+func (r *recording) event(kind string, b []byte) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.err != nil {
+		return
+	}
+	data := append(r.pending[kind], b...)
+	end := len(data)
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				end = i
+			}
+			break
+		}
+	}
+	r.pending[kind] = append([]byte{}, data[end:]...)
+	if end == 0 {
+		return
+	}
+	r.write(kind, string(data[:end]))
+}
+
+/* writes an event, the caller must hold the mutex */
+func (r *recording) write(kind string, data string) {
+	line, err := json.Marshal([]interface{}{
+		time.Since(r.start).Seconds(), kind, data,
+	})
+	if err == nil {
+		_, err = r.file.Write(append(line, '\n'))
+	}
+	if err != nil {
+		// e.g. the disk is full, stop recording rather than failing the terminal
+		r.err = errors.Wrap(err, "write recording")
+	}
+}
+
+func (r *recording) output(b []byte) {
+	r.event("o", b)
+}
+
+func (r *recording) input(b []byte) {
+	r.event("i", b)
+}
+
+func (r *recording) resize(rows uint32, cols uint32) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.err != nil {
+		return
+	}
+	r.write("r", fmt.Sprintf("%vx%v", cols, rows))
+}
+
+func (r *recording) close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for kind, data := range r.pending {
+		if len(data) > 0 && r.err == nil {
+			r.write(kind, string(data))
+		}
+	}
+	err := r.file.Close()
+	if r.err != nil {
+		return r.err
+	}
+	r.err = errors.New("recording closed")
+	return errors.Wrap(err, "close recording")
+}
+
+/* starts recording a terminal session, if a recording directory is configured */
+func (client *Client) startRecording(t *terminal) {
+	if client.RecordingDirectory == "" {
+		return
+	}
+	name := fmt.Sprintf("wrap-%v-%v-%v.cast", time.Now().Format("20060102-150405.000"), t.key.listenerId, t.key.sessionId)
+	title := fmt.Sprintf("wrap.sh terminal session %v", t.key.sessionId)
+	if client.hello != nil && client.hello.Slug != "" {
+		title = fmt.Sprintf("%v (%v)", title, client.hello.Slug)
+	}
+	rows, cols := 0, 0
+	if size, err := pty.GetsizeFull(t.bash); err == nil {
+		rows, cols = int(size.Rows), int(size.Cols)
+	}
+	r, err := newRecording(client.RecordingDirectory, name, title, t.shell, rows, cols)
+	if err != nil {
+		client.Log("Could not record terminal session %v: %v", t.key.sessionId, err)
+		return
+	}
+	client.debugLog("recording terminal session %v to %v", t.key.sessionId, r.path)
+	t.recording = r
+	if !client.SkipInputRecording {
+		// pasted tokens would otherwise end up in the uploaded recording
+		t.inputRedaction = newTimedRedactingStream(client.redactor, r.input)
+	}
+}
+
+/* finishes a terminal session's recording, and uploads it to the wrap.sh server if configured */
+func (client *Client) finishRecording(t *terminal) {
+	r := t.recording
+	if r == nil {
+		return
+	}
+	err := r.close()
+	if err != nil {
+		client.Log("Recording of terminal session %v is incomplete: %v", t.key.sessionId, err)
+	}
+	client.Log("Terminal session %v was recorded to %v", t.key.sessionId, r.path)
+	if !client.UploadRecordings {
+		return
+	}
+	data, err := ioutil.ReadFile(r.path)
+	if err != nil {
+		client.Log("Could not upload the recording: %v", err)
+		return
+	}
+	if len(data) > maxUploadedRecordingSize {
+		client.Log("The recording is too large to upload (%v bytes).", len(data))
+		return
+	}
+	err = client.send(&protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_TerminalRecording{
+			TerminalRecording: &protocol.TerminalRecording{
+				SessionId: t.key.sessionId,
+				Name:      filepath.Base(r.path),
+				Data:      data,
+			},
+		},
+		ListenerId: t.key.listenerId,
+	})
+	if err != nil {
+		client.Log("Could not upload the recording: %v", err)
+	}
+}
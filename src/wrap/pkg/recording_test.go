@@ -0,0 +1,63 @@
+package wrap
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRecording(t *testing.T) {
+	dir, err := ioutil.TempDir("", "Wrap.TestRecording")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	r, err := newRecording(dir, "test.cast", "test", "/bin/bash", 0, 0)
+	assertNil(t, "error", err)
+	r.input([]byte("echo é\r"))
+	// a multi-byte character split across two reads
+	r.output([]byte("\xc3"))
+	r.output([]byte("\xa9\r\n"))
+	r.resize(50, 120)
+	assertNil(t, "error", r.close())
+
+	b, err := ioutil.ReadFile(r.path)
+	assertNil(t, "error", err)
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	assertEqual(t, "line count", 4, len(lines))
+	assertEqual(t, "header", true, strings.HasPrefix(lines[0], `{"version":2,"width":80,"height":24,`))
+	assertEqual(t, "input", true, strings.HasSuffix(lines[1], `,"i","echo é\r"]`))
+	assertEqual(t, "output", true, strings.HasSuffix(lines[2], `,"o","é\r\n"]`))
+	assertEqual(t, "resize", true, strings.HasSuffix(lines[3], `,"r","120x50"]`))
+}
+
+func TestRecordingInputIsRedacted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "Wrap.TestRecording")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	record := func(sessionId uint32, recordInput bool) string {
+		c := newBlankTestClient()
+		c.RecordingDirectory = dir
+		c.SkipInputRecording = !recordInput
+		c.redactor = newRedactor([]string{"hunter2secret"}, nil)
+		term := &terminal{key: terminalKey{sessionId: sessionId}}
+		c.startRecording(term)
+		assertNotNil(t, "recording", term.recording)
+		if term.inputRedaction != nil {
+			term.inputRedaction.write([]byte("export TOKEN=hunter2secret\r"))
+			term.inputRedaction.close()
+		}
+		assertNil(t, "error", term.recording.close())
+		b, err := ioutil.ReadFile(term.recording.path)
+		assertNil(t, "error", err)
+		return string(b)
+	}
+	recorded := record(1, false)
+	assertEqual(t, "has input", false, strings.Contains(recorded, `"i"`))
+	recorded = record(2, true)
+	assertEqual(t, "has secret", false, strings.Contains(recorded, "hunter2"))
+	assertEqual(t, "has masked input", true, strings.Contains(recorded, `"i","export TOKEN=[REDACTED]\r"`))
+}
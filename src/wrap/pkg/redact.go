@@ -0,0 +1,486 @@
+package wrap
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const redactedText = "[REDACTED]"
+
+// shorter values are too likely to be ordinary words, e.g. "true"
+const minSecretLength = 6
+
+// streams hold back this much output, in case a token continues in the next read
+const tokenHoldback = 256
+
+/*
+Streams hold back output ending in a run of characters tokens are made of, e.g.
+a JSON web token, which can be any length, until the run ends or is this long.
+*/
+const maxTokenLength = 16 * 1024
+
+/*
+held back output is sent anyway if nothing follows within this time,
+except for an end which could be the start of a known secret
+*/
+const redactionFlushDelay = 50 * time.Millisecond
+
+/*
+environment variables whose values are redacted, unless RedactEnvPatterns is set.
+Not *AUTH*, which would take in paths like SSH_AUTH_SOCK
+*/
+var defaultRedactEnvPatterns = []string{
+	"*TOKEN*", "*SECRET*", "*PASSWORD*", "*PASSWD*", "*KEY*", "*CREDENTIAL*", "*_AUTH", "*AUTHORIZATION*",
+}
+
+// well-known token formats, redacted wherever they show up
+var tokenFormats = []*regexp.Regexp{
+	// GitHub
+	regexp.MustCompile(`gh[pousr]_[A-Za-z0-9]{36,255}`),
+	regexp.MustCompile(`github_pat_[A-Za-z0-9_]{22,255}`),
+	// GitLab
+	regexp.MustCompile(`glpat-[A-Za-z0-9_-]{20}`),
+	// AWS access key IDs
+	regexp.MustCompile(`(A3T[A-Z0-9]|AKIA|ASIA)[A-Z0-9]{16}`),
+	// Slack
+	regexp.MustCompile(`xox[abposr]-[A-Za-z0-9-]{10,200}`),
+	// npm
+	regexp.MustCompile(`npm_[A-Za-z0-9]{36}`),
+	// Google API keys
+	regexp.MustCompile(`AIza[0-9A-Za-z_-]{35}`),
+	// Stripe
+	regexp.MustCompile(`[rs]k_live_[0-9A-Za-z]{24,99}`),
+	// JSON web tokens
+	regexp.MustCompile(`eyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`),
+}
+
+/*
+Masks secrets in data before it's sent to the wrap.sh server: known values,
+such as those of environment variables with names like *TOKEN*, and
+well-known token formats. A nil redactor leaves data as it is.
+*/
+type redactor struct {
+	// longest first, so that a secret containing another is masked whole
+	secrets  [][]byte
+	formats  []*regexp.Regexp
+	holdback int
+}
+
+func newRedactor(secrets []string, formats []*regexp.Regexp) *redactor {
+	r := &redactor{
+		formats:  formats,
+		holdback: tokenHoldback,
+	}
+	seen := map[string]bool{}
+	for _, secret := range secrets {
+		if len(secret) < minSecretLength || seen[secret] {
+			continue
+		}
+		seen[secret] = true
+		r.secrets = append(r.secrets, []byte(secret))
+		if len(secret)-1 > r.holdback {
+			r.holdback = len(secret) - 1
+		}
+	}
+	sort.Slice(r.secrets, func(i, j int) bool {
+		return len(r.secrets[i]) > len(r.secrets[j])
+	})
+	return r
+}
+
+/* builds the redactor from the settings and the environment */
+func (client *Client) newRedactor() *redactor {
+	patterns := client.RedactEnvPatterns
+	if patterns == nil {
+		patterns = defaultRedactEnvPatterns
+	}
+	secrets := envSecrets(os.Environ(), patterns)
+	secrets = append(secrets, client.Secrets...)
+	if client.Token != "" {
+		secrets = append(secrets, client.Token)
+	}
+	return newRedactor(secrets, tokenFormats)
+}
+
+/* returns the values of the environment variables whose names match any of the patterns */
+func envSecrets(environ []string, patterns []string) []string {
+	var secrets []string
+	for _, entry := range environ {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		name := strings.ToUpper(parts[0])
+		for _, pattern := range patterns {
+			if ok, _ := filepath.Match(strings.ToUpper(pattern), name); ok {
+				secrets = append(secrets, parts[1])
+				break
+			}
+		}
+	}
+	return secrets
+}
+
+type span struct {
+	start int
+	end   int
+}
+
+/* returns the sorted, non-overlapping spans of b which contain secrets */
+func (r *redactor) matches(b []byte) []span {
+	var spans []span
+	for _, secret := range r.secrets {
+		for offset := 0; ; {
+			i := bytes.Index(b[offset:], secret)
+			if i < 0 {
+				break
+			}
+			spans = append(spans, span{offset + i, offset + i + len(secret)})
+			offset += i + len(secret)
+		}
+	}
+	for _, format := range r.formats {
+		for _, match := range format.FindAllIndex(b, -1) {
+			spans = append(spans, span{match[0], match[1]})
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].start < spans[j].start
+	})
+	var merged []span
+	for _, s := range spans {
+		if len(merged) > 0 && s.start <= merged[len(merged)-1].end {
+			if s.end > merged[len(merged)-1].end {
+				merged[len(merged)-1].end = s.end
+			}
+			continue
+		}
+		merged = append(merged, s)
+	}
+	return merged
+}
+
+/* replaces the given spans of b with redactedText */
+func maskSpans(b []byte, spans []span) []byte {
+	if len(spans) == 0 {
+		return b
+	}
+	var out bytes.Buffer
+	last := 0
+	for _, s := range spans {
+		if s.end > len(b) {
+			break
+		}
+		out.Write(b[last:s.start])
+		out.WriteString(redactedText)
+		last = s.end
+	}
+	out.Write(b[last:])
+	return out.Bytes()
+}
+
+/* masks the secrets in b, which must be complete, e.g. a whole file */
+func (r *redactor) redact(b []byte) []byte {
+	if r == nil {
+		return b
+	}
+	return maskSpans(b, r.matches(b))
+}
+
+/*
+Masks the secrets in a part of b, returning only that part. b must have at
+least margin() bytes on either side of the part, unless it's the start or end
+of a file, so that secrets cut off by the edges of the part are found too.
+*/
+func (r *redactor) redactPart(b []byte, part span) []byte {
+	if r == nil {
+		return b[part.start:part.end]
+	}
+	var spans []span
+	for _, s := range r.matches(b) {
+		if s.end <= part.start || s.start >= part.end {
+			continue
+		}
+		if s.start < part.start {
+			s.start = part.start
+		}
+		if s.end > part.end {
+			s.end = part.end
+		}
+		spans = append(spans, span{s.start - part.start, s.end - part.start})
+	}
+	return maskSpans(b[part.start:part.end], spans)
+}
+
+/* returns how long the end of b is which could be the start of a known secret */
+func (r *redactor) secretPrefixLength(b []byte) int {
+	longest := 0
+	for _, secret := range r.secrets {
+		n := len(secret) - 1
+		if n > len(b) {
+			n = len(b)
+		}
+		for ; n > longest; n-- {
+			if bytes.HasSuffix(b, secret[:n]) {
+				longest = n
+				break
+			}
+		}
+	}
+	return longest
+}
+
+/* how much output around some other output is enough to find any secret overlapping it */
+func (r *redactor) margin() int {
+	if r == nil {
+		return 0
+	}
+	if len(r.formats) > 0 && r.holdback < maxTokenLength {
+		return maxTokenLength
+	}
+	return r.holdback
+}
+
+func isTokenByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-' || c == '.'
+}
+
+/* returns where the run of token characters at the end of b starts, if it could be the start of a token */
+func (r *redactor) trailingTokenStart(b []byte) int {
+	i := len(b)
+	if len(r.formats) == 0 {
+		return i
+	}
+	for i > 0 && isTokenByte(b[i-1]) && len(b)-i < maxTokenLength {
+		i--
+	}
+	return i
+}
+
+func (r *redactor) stream() *redactingStream {
+	return &redactingStream{redactor: r}
+}
+
+/*
+Masks secrets in a stream of output, such as a terminal's. Secrets can be
+split across reads, so the end of the output is held back until it's
+known not to be the start of a secret, or flushed.
+*/
+type redactingStream struct {
+	redactor *redactor
+	pending  []byte
+	// how many secrets were masked so far
+	masked int
+	// leading bytes which are only written to find secrets overlapping the
+	// rest, and left out of the output, e.g. the margin before a download's offset
+	skip int
+}
+
+/* returns the output which is safe to send, holding back the rest */
+func (s *redactingStream) write(b []byte) []byte {
+	if s.redactor == nil {
+		return b
+	}
+	buf := append(s.pending, b...)
+	spans := s.redactor.matches(buf)
+	cut := len(buf) - s.redactor.holdback
+	if cut < 0 {
+		cut = 0
+	}
+	if start := s.redactor.trailingTokenStart(buf); start < cut {
+		cut = start
+	}
+	var complete []span
+	for _, sp := range spans {
+		if sp.end == len(buf) {
+			// e.g. a token which might continue in the next read
+			if sp.start < cut {
+				cut = sp.start
+			}
+			break
+		}
+		if sp.start >= cut {
+			break
+		}
+		if sp.end > cut {
+			cut = sp.end
+		}
+		complete = append(complete, sp)
+	}
+	out := s.emit(buf[:cut], complete)
+	s.pending = append([]byte{}, buf[cut:]...)
+	return out
+}
+
+/* returns the held back output, once nothing more is expected soon */
+func (s *redactingStream) flush() []byte {
+	if s.redactor == nil || len(s.pending) == 0 {
+		return nil
+	}
+	out := s.emit(s.pending, s.redactor.matches(s.pending))
+	s.pending = nil
+	return out
+}
+
+/* masks the secrets at the given spans of b, leaving out the bytes still to be skipped */
+func (s *redactingStream) emit(b []byte, spans []span) []byte {
+	skip := s.skip
+	if skip > len(b) {
+		skip = len(b)
+	}
+	s.skip -= skip
+	var kept []span
+	for _, sp := range spans {
+		if sp.end <= skip {
+			continue
+		}
+		if sp.start < skip {
+			// the rest of a secret which started in the skipped bytes
+			sp.start = skip
+		}
+		kept = append(kept, span{sp.start - skip, sp.end - skip})
+	}
+	s.masked += len(kept)
+	return maskSpans(b[skip:], kept)
+}
+
+/*
+Returns the held back output, except for an end which could be the start of a
+known secret value, e.g. once the output has paused. Secrets typed or written
+slowly are still masked whole, once the rest of them follows.
+*/
+func (s *redactingStream) flushUnlessSecret() []byte {
+	if s.redactor == nil || len(s.pending) == 0 {
+		return nil
+	}
+	cut := len(s.pending) - s.redactor.secretPrefixLength(s.pending)
+	var complete []span
+	for _, sp := range s.redactor.matches(s.pending) {
+		if sp.end > cut {
+			if sp.start < cut {
+				cut = sp.start
+			}
+			break
+		}
+		complete = append(complete, sp)
+	}
+	out := s.emit(s.pending[:cut], complete)
+	s.pending = append([]byte{}, s.pending[cut:]...)
+	return out
+}
+
+func (s *redactingStream) hasPending() bool {
+	return len(s.pending) > 0
+}
+
+/* returns a reader masking the secrets in what it reads from src, like a redactingStream */
+func (r *redactor) reader(src io.Reader) io.Reader {
+	if r == nil {
+		return src
+	}
+	return &redactingReader{src: src, stream: r.stream(), buf: make([]byte, 32*1024)}
+}
+
+type redactingReader struct {
+	src    io.Reader
+	stream *redactingStream
+	buf    []byte
+	out    bytes.Buffer
+	err    error
+}
+
+func (r *redactingReader) Read(p []byte) (int, error) {
+	for r.out.Len() == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		n, err := r.src.Read(r.buf)
+		r.out.Write(r.stream.write(r.buf[:n]))
+		if err != nil {
+			r.out.Write(r.stream.flush())
+			r.err = err
+		}
+	}
+	return r.out.Read(p)
+}
+
+/*
+A redactingStream which flushes itself shortly after the output stops,
+so interactive output isn't held back. What could be the start of a known
+secret is held back until more output follows, or the stream is closed.
+*/
+type timedRedactingStream struct {
+	mutex  sync.Mutex
+	stream *redactingStream
+	output func([]byte)
+	timer  *time.Timer
+	closed bool
+	// incremented by every write, so a timer which fired late doesn't flush newer output
+	writes int
+}
+
+func newTimedRedactingStream(r *redactor, output func([]byte)) *timedRedactingStream {
+	return &timedRedactingStream{stream: r.stream(), output: output}
+}
+
+func (s *timedRedactingStream) write(b []byte) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.closed {
+		return
+	}
+	if out := s.stream.write(b); len(out) > 0 {
+		s.output(out)
+	}
+	s.writes++
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	if s.stream.hasPending() {
+		writes := s.writes
+		s.timer = time.AfterFunc(redactionFlushDelay, func() {
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+			if s.writes == writes && !s.closed {
+				if out := s.stream.flushUnlessSecret(); len(out) > 0 {
+					s.output(out)
+				}
+			}
+		})
+	}
+}
+
+/* outputs the held back output, the caller must hold the mutex */
+func (s *timedRedactingStream) flush() {
+	if s.closed {
+		return
+	}
+	if out := s.stream.flush(); len(out) > 0 {
+		s.output(out)
+	}
+}
+
+/* outputs the held back output now, without waiting for the timer */
+func (s *timedRedactingStream) flushPending() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.flush()
+}
+
+/* flushes the held back output, nothing is output after this returns */
+func (s *timedRedactingStream) close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.flush()
+	s.closed = true
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+}
@@ -0,0 +1,87 @@
+package wrap
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRedactSplitSecret(t *testing.T) {
+	r := newRedactor([]string{"hunter22"}, nil)
+	s := r.stream()
+	var out strings.Builder
+	out.Write(s.write([]byte("password is hun")))
+	out.Write(s.write([]byte("ter22, done")))
+	out.Write(s.flush())
+	assertEqual(t, "output", "password is [REDACTED], done", out.String())
+}
+
+func TestRedactTokenFormats(t *testing.T) {
+	r := newRedactor(nil, tokenFormats)
+	token := "ghp_" + strings.Repeat("a", 36)
+	s := r.stream()
+	var out strings.Builder
+	out.Write(s.write([]byte("token " + token[:20])))
+	out.Write(s.write([]byte(token[20:])))
+	// the token could continue in the next write, so it's held back
+	assertEqual(t, "token sent before flush", false, strings.Contains(out.String(), "ghp_"))
+	out.Write(s.flush())
+	assertEqual(t, "output", "token [REDACTED]", out.String())
+	assertEqual(t, "short value", "abc", string(newRedactor([]string{"abc"}, nil).redact([]byte("abc"))))
+}
+
+func TestRedactSplitLongToken(t *testing.T) {
+	r := newRedactor(nil, tokenFormats)
+	token := "eyJ" + strings.Repeat("a", 100) + ".eyJ" + strings.Repeat("b", 300) + "." + strings.Repeat("c", 102)
+	s := r.stream()
+	var out strings.Builder
+	out.Write(s.write([]byte("Authorization: Bearer " + token[:350])))
+	out.Write(s.write([]byte(token[350:] + "\n")))
+	out.Write(s.flush())
+	assertEqual(t, "output", "Authorization: Bearer [REDACTED]\n", out.String())
+
+	// output which isn't a token is sent once the run of token characters ends
+	s = r.stream()
+	assertEqual(t, "held back", "", string(s.write([]byte(strings.Repeat("x", 300)))))
+	assertEqual(t, "sent", 301-tokenHoldback, len(s.write([]byte(" "))))
+}
+
+func TestEnvSecrets(t *testing.T) {
+	environ := []string{"GITHUB_TOKEN=secret-value", "HOME=/root", "db_password=p4ssw0rd",
+		"SSH_AUTH_SOCK=/tmp/ssh-agent.sock", "NPM_AUTH=npm-auth"}
+	secrets := envSecrets(environ, defaultRedactEnvPatterns)
+	assertEqual(t, "secrets", "secret-value,p4ssw0rd,npm-auth", strings.Join(secrets, ","))
+}
+
+func TestRedactSkippedStart(t *testing.T) {
+	r := newRedactor([]string{"hunter22"}, nil)
+	s := r.stream()
+	// the secret starts in the skipped bytes, only its end is output, masked
+	s.skip = len("password is hun")
+	var out strings.Builder
+	out.Write(s.write([]byte("password is hunter22, done")))
+	out.Write(s.flush())
+	assertEqual(t, "output", "[REDACTED], done", out.String())
+	assertEqual(t, "masked", 1, s.masked)
+}
+
+func TestTimedRedactSlowSecret(t *testing.T) {
+	r := newRedactor([]string{"hunter22"}, nil)
+	var mutex sync.Mutex
+	var out strings.Builder
+	s := newTimedRedactingStream(r, func(b []byte) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		out.Write(b)
+	})
+	s.write([]byte("password is hun"))
+	// e.g. typed into a terminal, the output before the secret still shows up
+	time.Sleep(4 * redactionFlushDelay)
+	mutex.Lock()
+	assertEqual(t, "output after a pause", "password is ", out.String())
+	mutex.Unlock()
+	s.write([]byte("ter22, done"))
+	s.close()
+	assertEqual(t, "output", "password is [REDACTED], done", out.String())
+}
@@ -0,0 +1,139 @@
+package wrap
+
+import (
+	"fmt"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"sort"
+	"time"
+)
+
+/* streams the output of a rerun in a new pty to the listener which requested it */
+type rerunOutputWriter struct {
+	client     *Client
+	rerunId    uint32
+	listenerId uint32
+	failed     bool
+	redaction  *timedRedactingStream
+}
+
+func (client *Client) newRerunOutputWriter(rerunId uint32, listenerId uint32) *rerunOutputWriter {
+	w := &rerunOutputWriter{
+		client:     client,
+		rerunId:    rerunId,
+		listenerId: listenerId,
+	}
+	w.redaction = newTimedRedactingStream(client.redactor, w.send)
+	return w
+}
+
+func (w *rerunOutputWriter) Write(b []byte) (int, error) {
+	w.redaction.write(b)
+	return len(b), nil
+}
+
+func (w *rerunOutputWriter) send(b []byte) {
+	if w.failed {
+		// keep draining the pty, so the command isn't blocked on its output
+		return
+	}
+	err := w.client.send(&protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_RerunOutput{
+			RerunOutput: &protocol.RerunOutput{
+				RerunId: w.rerunId,
+				Data:    b,
+			},
+		},
+		ListenerId: w.listenerId,
+	})
+	if err != nil {
+		w.client.debugLog(errors.Wrap(err, "send rerun output").Error())
+		w.failed = true
+	}
+}
+
+func (client *Client) handleRerunCommand(msg *protocol.RerunCommand, listenerId uint32) error {
+	command := msg.GetCommand()
+	if command == "" {
+		command = client.TestCommand
+	}
+	var t *terminal
+	var err error
+	if command == "" {
+		err = errors.New("there is no command to rerun")
+	} else if msg.GetInTerminal() {
+		t = client.getTerminal(msg.GetSessionId(), listenerId)
+		if t == nil {
+			err = errors.Errorf("terminal session %v isn't open", msg.GetSessionId())
+		}
+	}
+	if err != nil {
+		client.sendRerunResult(&protocol.RerunResult{
+			RerunId: msg.GetRerunId(),
+			Error:   err.Error(),
+		}, listenerId)
+		return err
+	}
+	go client.rerun(msg, command, t, listenerId)
+	return nil
+}
+
+func (client *Client) sendRerunResult(result *protocol.RerunResult, listenerId uint32) {
+	err := client.send(&protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_RerunResult{
+			RerunResult: result,
+		},
+		ListenerId: listenerId,
+	})
+	if err != nil {
+		client.debugLog(errors.Wrap(err, "send rerun result").Error())
+	}
+}
+
+/*
+Runs the command like the test command, either in a new pty whose output is
+streamed to the dashboard, or in an open terminal session. It's subject to the
+same time limit as each attempt.
+*/
+func (client *Client) rerun(msg *protocol.RerunCommand, command string, t *terminal, listenerId uint32) {
+	cmd := testCommand(command)
+	keys := make([]string, 0, len(msg.GetEnv()))
+	for key := range msg.GetEnv() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		cmd.Env = append(cmd.Env, key+"="+msg.GetEnv()[key])
+	}
+	var deadline time.Time
+	if client.AttemptTimeout > 0 {
+		deadline = time.Now().Add(client.AttemptTimeout)
+	}
+	client.Log("Re-running \"%v\" for the dashboard", command)
+	start := time.Now()
+	var err error
+	if t != nil {
+		// the shell keeps the terminal's input, the command only shares its output
+		fmt.Fprintf(t.tty, "\n[wrap.sh] Re-running %v\n", command)
+		cmd.Stdout, cmd.Stderr = t.tty, t.tty
+		err = client.startAndWait(cmd, deadline)
+	} else {
+		output := client.newRerunOutputWriter(msg.GetRerunId(), listenerId)
+		err = client.runInPty(cmd, deadline, output)
+		output.redaction.close()
+	}
+	result := &protocol.RerunResult{
+		RerunId:    msg.GetRerunId(),
+		DurationMs: uint64(time.Since(start) / time.Millisecond),
+	}
+	exitCode, timedOut, err := commandResult(err)
+	if err != nil {
+		result.Error = errors.Wrap(err, "run command").Error()
+	}
+	result.ExitCode = int32(exitCode)
+	result.TimedOut = timedOut
+	if t != nil && err == nil {
+		fmt.Fprintf(t.tty, "[wrap.sh] Exit code %v after %v\n", exitCode, time.Since(start).Round(time.Millisecond))
+	}
+	client.sendRerunResult(result, listenerId)
+}
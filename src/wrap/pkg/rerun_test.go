@@ -0,0 +1,60 @@
+package wrap
+
+import (
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"strings"
+	"testing"
+)
+
+func TestRerunCommand(t *testing.T) {
+	c := newBlankTestClient()
+	c.TestCommand = "true"
+	messages, done := connectTestClient(t, c)
+	defer done()
+	err := c.HandleMessage(&protocol.MessageToWrapClient{
+		Spec: &protocol.MessageToWrapClient_RerunCommand{
+			RerunCommand: &protocol.RerunCommand{
+				RerunId: 7,
+				Command: "echo hello $WRAPSH_TEST; exit 3",
+				Env:     map[string]string{"WRAPSH_TEST": "world"},
+			},
+		},
+		ListenerId: 1,
+	})
+	assertNil(t, "error", err)
+	var output strings.Builder
+	result := waitForMessage(t, messages, func(msg *protocol.MessageFromWrapClient) bool {
+		if rerunOutput := msg.GetRerunOutput(); rerunOutput != nil {
+			assertEqual(t, "RerunId", uint32(7), rerunOutput.RerunId)
+			assertEqual(t, "ListenerId", uint32(1), msg.ListenerId)
+			output.Write(rerunOutput.Data)
+		}
+		return msg.GetRerunResult() != nil
+	}).GetRerunResult()
+	assertEqual(t, "RerunId", uint32(7), result.RerunId)
+	assertEqual(t, "ExitCode", int32(3), result.ExitCode)
+	assertEqual(t, "TimedOut", false, result.TimedOut)
+	assertEqual(t, "Error", "", result.Error)
+	assertEqual(t, "output", "hello world", strings.TrimSpace(output.String()))
+}
+
+func TestRerunCommandDenied(t *testing.T) {
+	c := newBlankTestClient()
+	c.TestCommand = "true"
+	c.TerminalAccess = protocol.Access_READ_ONLY
+	messages, done := connectTestClient(t, c)
+	defer done()
+	err := c.HandleMessage(&protocol.MessageToWrapClient{
+		Spec: &protocol.MessageToWrapClient_RerunCommand{
+			RerunCommand: &protocol.RerunCommand{RerunId: 7},
+		},
+		ListenerId: 1,
+	})
+	assertNotNil(t, "error", err)
+	denied := waitForMessage(t, messages, func(msg *protocol.MessageFromWrapClient) bool {
+		assertNil(t, "rerun result", msg.GetRerunResult())
+		return msg.GetError() != ""
+	})
+	assertEqual(t, "Error", "access denied: the terminal is read-only", denied.GetError())
+	assertEqual(t, "ListenerId", uint32(1), denied.ListenerId)
+}
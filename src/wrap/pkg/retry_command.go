@@ -0,0 +1,124 @@
+package wrap
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/pkg/errors"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+/*
+testList renders as its GoRunRegex, made safe to put in single quotes, so that
+"go test -run '{{.FailedTests}}'" works. Words gives the failed tests' names as
+separately quoted shell words, e.g. for pytest node IDs, and Names as they are.
+*/
+type testList []string
+
+func (l testList) String() string {
+	// a quote would end the shell word, . matches it just as well
+	return strings.ReplaceAll(l.GoRunRegex(), "'", ".")
+}
+
+func (l testList) Words() string {
+	return fileList(l).String()
+}
+
+func (l testList) Names() []string {
+	return l
+}
+
+/*
+Returns a regular expression matching exactly the tests, e.g. ^(TestA|TestB)$.
+Names are escaped, so brackets and dots in them match literally. go test -run
+splits its pattern on slashes, so a subtest like TestB/sub reruns all of TestB.
+*/
+func (l testList) GoRunRegex() string {
+	var quoted []string
+	seen := map[string]bool{}
+	for _, name := range l {
+		name = strings.SplitN(name, "/", 2)[0]
+		if !seen[name] {
+			seen[name] = true
+			quoted = append(quoted, regexp.QuoteMeta(name))
+		}
+	}
+	return "^(" + strings.Join(quoted, "|") + ")$"
+}
+
+// fileList renders as a space-separated list of files, each quoted as a single shell word
+type fileList []string
+
+func (l fileList) String() string {
+	quoted := make([]string, len(l))
+	for i, file := range l {
+		quoted[i] = shellQuote(file)
+	}
+	return strings.Join(quoted, " ")
+}
+
+type retryCommandData struct {
+	Command     string
+	FailedTests testList
+	FailedFiles fileList
+}
+
+/* quotes s for use as a single bash word */
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+var retryCommandFuncs = template.FuncMap{
+	"join": func(list []string, sep string) string {
+		return strings.Join(list, sep)
+	},
+	// e.g. {{quote .FailedTests.GoRunRegex}}
+	"quote": func(v interface{}) string {
+		return shellQuote(fmt.Sprint(v))
+	},
+}
+
+/*
+Renders the RetryCommand template with the failures from a test report,
+e.g. "go test -run {{quote .FailedTests.GoRunRegex}}" or "pytest {{.FailedFiles}}".
+*/
+func renderRetryCommand(text string, command string, report *protocol.TestReport) (string, error) {
+	tmpl, err := template.New("RetryCommand").Funcs(retryCommandFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", errors.Wrap(err, "parse retry command")
+	}
+	data := &retryCommandData{Command: command}
+	seenTests := map[string]bool{}
+	seenFiles := map[string]bool{}
+	for _, failure := range report.GetFailure() {
+		if !seenTests[failure.Name] {
+			seenTests[failure.Name] = true
+			data.FailedTests = append(data.FailedTests, failure.Name)
+		}
+		if failure.File != "" && !seenFiles[failure.File] {
+			seenFiles[failure.File] = true
+			data.FailedFiles = append(data.FailedFiles, failure.File)
+		}
+	}
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, data)
+	if err != nil {
+		return "", errors.Wrap(err, "render retry command")
+	}
+	return buf.String(), nil
+}
+
+/* returns the command to run for the next retry */
+func (client *Client) retryCommand() string {
+	if client.RetryCommand == "" || len(client.testReport.GetFailure()) == 0 {
+		return client.TestCommand
+	}
+	command, err := renderRetryCommand(client.RetryCommand, client.TestCommand, client.testReport)
+	if err != nil {
+		client.Log("%v, rerunning the whole command", err)
+		return client.TestCommand
+	}
+	return command
+}
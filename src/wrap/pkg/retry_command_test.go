@@ -0,0 +1,42 @@
+package wrap
+
+import (
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"testing"
+)
+
+func TestRenderRetryCommand(t *testing.T) {
+	report := &protocol.TestReport{
+		Failure: []*protocol.TestFailure{
+			{Name: "TestA", File: "/src/a_test.go"},
+			{Name: "TestB", File: "/src/a_test.go"},
+			{Name: "TestC", File: "/src/it's_test.go"},
+			{Name: "TestD/sub[1]", File: "/src/my tests/d_test.go"},
+			{Name: "TestD/sub.2", File: "/src/my tests/d_test.go"},
+			{Name: "Test.E", File: "/src/a_test.go"},
+		},
+	}
+	for text, expected := range map[string]string{
+		"go test -run {{quote .FailedTests.GoRunRegex}}":    "go test -run '^(TestA|TestB|TestC|TestD|Test\\.E)$'",
+		"go test -run '{{.FailedTests}}'":                   "go test -run '^(TestA|TestB|TestC|TestD|Test\\.E)$'",
+		"pytest {{.FailedTests.Words}}":                     "pytest 'TestA' 'TestB' 'TestC' 'TestD/sub[1]' 'TestD/sub.2' 'Test.E'",
+		"pytest {{.FailedFiles}}":                           `pytest '/src/a_test.go' '/src/it'\''s_test.go' '/src/my tests/d_test.go'`,
+		"pytest{{range .FailedFiles}} {{quote .}}{{end}}":   `pytest '/src/a_test.go' '/src/it'\''s_test.go' '/src/my tests/d_test.go'`,
+		"{{.Command}} --only {{join .FailedTests \",\"}}":   "npm test --only TestA,TestB,TestC,TestD/sub[1],TestD/sub.2,Test.E",
+		"jest -t {{quote (join .FailedTests.Names \"|\")}}": "jest -t 'TestA|TestB|TestC|TestD/sub[1]|TestD/sub.2|Test.E'",
+	} {
+		command, err := renderRetryCommand(text, "npm test", report)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, "command", expected, command)
+	}
+	// a quote in a name can't end the quoted pattern
+	command, err := renderRetryCommand("go test -run '{{.FailedTests}}'", "go test", &protocol.TestReport{
+		Failure: []*protocol.TestFailure{{Name: "TestIt's"}, {Name: "TestB"}},
+	})
+	assertNil(t, "error", err)
+	assertEqual(t, "command", "go test -run '^(TestIt.s|TestB)$'", command)
+	_, err = renderRetryCommand("{{.Missing}}", "npm test", report)
+	assertNotNil(t, "error", err)
+}
@@ -0,0 +1,193 @@
+package wrap
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+const defaultShell = "bash"
+
+// tried in order when the configured shell isn't installed, e.g. on Alpine images
+var fallbackShells = []string{"bash", "sh"}
+
+// the test command runs with the first of these which is installed
+var commandShells = []string{"bash", "sh"}
+
+/* returns the shell the test command and reruns of it run with */
+func commandShell() string {
+	for _, shell := range commandShells {
+		if path, err := exec.LookPath(shell); err == nil {
+			return path
+		}
+	}
+	return "sh"
+}
+
+/*
+Prepares a command to run like the test command, with the same shell and the
+client's environment and working directory. The terminal's ShellEnv and
+ShellDirectory don't apply, so that a rerun behaves like the pipeline's run.
+*/
+func testCommand(command string) *exec.Cmd {
+	cmd := exec.Command(commandShell(), "-c", command)
+	cmd.Env = os.Environ()
+	return cmd
+}
+
+/* returns the path of the shell to start in terminals, falling back to one which is installed */
+func (client *Client) findShell() (string, error) {
+	shell := client.Shell
+	if shell == "" {
+		shell = defaultShell
+	}
+	path, err := exec.LookPath(shell)
+	if err == nil {
+		return path, nil
+	}
+	for _, fallback := range fallbackShells {
+		if path, err := exec.LookPath(fallback); err == nil {
+			client.Log("The shell %q wasn't found, using %v instead.", shell, path)
+			return path, nil
+		}
+	}
+	return "", errors.Errorf("no shell found, tried %v and %v", shell, strings.Join(fallbackShells, ", "))
+}
+
+/* the banner shown when a terminal opens, describing why the debug session was started */
+func (client *Client) shellBanner() []string {
+	lines := []string{"wrap.sh debug shell"}
+	if client.TestCommand == "" {
+		return lines
+	}
+	if !client.isCommandFinished() {
+		return append(lines, fmt.Sprintf("The command %q is still running (pid %v).", client.TestCommand, atomic.LoadInt32(&client.hungPid)))
+	}
+	switch {
+	case client.commandTimedOut:
+		lines = append(lines, fmt.Sprintf("The command %q timed out.", client.TestCommand))
+	case client.ExitCode != 0:
+		lines = append(lines, fmt.Sprintf("The command %q failed with exit code %v.", client.TestCommand, client.ExitCode))
+	default:
+		lines = append(lines, fmt.Sprintf("The command %q succeeded.", client.TestCommand))
+	}
+	if len(client.attempts) > 1 {
+		lines = append(lines, fmt.Sprintf("It was run %v times.", len(client.attempts)))
+	}
+	return append(lines, "Run `rerun` to run it again.")
+}
+
+/*
+Returns the rc file contents for a shell: the banner and some aliases,
+then the user's own rc file.
+*/
+func (client *Client) shellRc(userRc string) string {
+	var rc strings.Builder
+	// interactive shells usually read this themselves, but not with a custom rc file
+	if userRc != "" {
+		fmt.Fprintf(&rc, "[ -r %v ] && . %v\n", shellQuote(userRc), shellQuote(userRc))
+	}
+	rc.WriteString("alias ll='ls -alF'\n")
+	if client.TestCommand != "" {
+		// like testCommand, though the terminal's environment variables are kept
+		dir, _ := os.Getwd()
+		fmt.Fprintf(&rc, "rerun() { (cd %v && %v -c %v); }\n",
+			shellQuote(dir), shellQuote(commandShell()), shellQuote(client.TestCommand))
+	}
+	for _, line := range client.shellBanner() {
+		fmt.Fprintf(&rc, "printf '%%s\\n' %v\n", shellQuote(line))
+	}
+	if client.ShellRcFile != "" {
+		fmt.Fprintf(&rc, ". %v\n", shellQuote(client.ShellRcFile))
+	}
+	return rc.String()
+}
+
+/*
+Prepares the command starting a terminal's shell, with the configured
+arguments, environment and directory. Bash, zsh and sh are made to read
+a generated rc file, unless arguments were configured. The returned
+function removes the rc file once the shell has exited.
+*/
+func (client *Client) shellCommand() (*exec.Cmd, func(), error) {
+	path, err := client.findShell()
+	if err != nil {
+		return nil, nil, err
+	}
+	cmd := exec.Command(path, client.ShellArgs...)
+	cmd.Env = os.Environ()
+	if client.TestCommand != "" {
+		cmd.Env = append(cmd.Env, "WRAPSH_COMMAND="+client.TestCommand)
+		if client.isCommandFinished() {
+			cmd.Env = append(cmd.Env, fmt.Sprintf("WRAPSH_EXIT_CODE=%v", client.ExitCode))
+		}
+	}
+	keys := make([]string, 0, len(client.ShellEnv))
+	for key := range client.ShellEnv {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		cmd.Env = append(cmd.Env, key+"="+client.ShellEnv[key])
+	}
+	if client.ShellDirectory != "" {
+		if info, err := os.Stat(client.ShellDirectory); err == nil && info.IsDir() {
+			cmd.Dir = client.ShellDirectory
+		} else {
+			client.Log("The shell directory %v doesn't exist, starting in the current directory.", client.ShellDirectory)
+		}
+	}
+	cleanup := func() {}
+	if len(client.ShellArgs) > 0 {
+		return cmd, cleanup, nil
+	}
+	dir, err := ioutil.TempDir("", "wrap-shell")
+	if err != nil {
+		client.debugLog("could not write the shell rc file: %v", err)
+		return cmd, cleanup, nil
+	}
+	cleanup = func() {
+		_ = os.RemoveAll(dir)
+	}
+	home, _ := os.UserHomeDir()
+	var rcPath, rc string
+	switch filepath.Base(path) {
+	case "bash":
+		rcPath = filepath.Join(dir, "bashrc")
+		rc = client.shellRc(filepath.Join(home, ".bashrc"))
+		cmd.Args = append(cmd.Args, "--rcfile", rcPath, "-i")
+	case "zsh":
+		// zsh reads $ZDOTDIR/.zshrc, the user's own one is sourced from there
+		rcPath = filepath.Join(dir, ".zshrc")
+		zdotdir := os.Getenv("ZDOTDIR")
+		if zdotdir == "" {
+			zdotdir = home
+		}
+		rc = client.shellRc(filepath.Join(zdotdir, ".zshrc"))
+		if original, ok := os.LookupEnv("ZDOTDIR"); ok {
+			rc = "ZDOTDIR=" + shellQuote(original) + "\n" + rc
+		} else {
+			rc = "unset ZDOTDIR\n" + rc
+		}
+		cmd.Env = append(cmd.Env, "ZDOTDIR="+dir)
+	case "sh", "dash", "ash":
+		// read by interactive POSIX shells
+		rcPath = filepath.Join(dir, "shrc")
+		rc = client.shellRc(os.Getenv("ENV"))
+		cmd.Env = append(cmd.Env, "ENV="+rcPath)
+		cmd.Args = append(cmd.Args, "-i")
+	default:
+		return cmd, cleanup, nil
+	}
+	err = ioutil.WriteFile(rcPath, []byte(rc), 0600)
+	if err != nil {
+		client.debugLog("could not write the shell rc file: %v", err)
+	}
+	return cmd, cleanup, nil
+}
@@ -0,0 +1,56 @@
+package wrap
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestShellCommand(t *testing.T) {
+	if _, err := exec.LookPath("bash"); err != nil {
+		t.Skip("bash isn't installed")
+	}
+	// don't load the user's own bashrc
+	home := os.Getenv("HOME")
+	defer os.Setenv("HOME", home)
+	os.Setenv("HOME", "/nonexistent")
+	client := newBlankTestClient()
+	client.TestCommand = "echo 'it''s broken'; exit 3"
+	client.ExitCode = 3
+	client.commandFinished = 1
+	client.ShellEnv = map[string]string{"WRAPSH_TEST": "yes"}
+	client.ShellDirectory = "/"
+	cmd, cleanup, err := client.shellCommand()
+	assertNil(t, "error", err)
+	defer cleanup()
+	cmd.Args = append(cmd.Args, "-c", "rerun; echo $? $WRAPSH_EXIT_CODE $WRAPSH_TEST $PWD")
+	out, err := cmd.CombinedOutput()
+	assertNil(t, "error", err)
+	assertEqual(t, "banner", true, strings.Contains(string(out), "failed with exit code 3"))
+	assertEqual(t, "last line", "3 3 yes /", lastLine(string(out)))
+}
+
+func lastLine(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	return lines[len(lines)-1]
+}
+
+func TestFindShellFallsBack(t *testing.T) {
+	client := newBlankTestClient()
+	client.Shell = "no-such-shell"
+	path, err := client.findShell()
+	assertNil(t, "error", err)
+	assertEqual(t, "fallback", true, strings.HasSuffix(path, "sh"))
+}
+
+func TestRerunUsesCommandShell(t *testing.T) {
+	client := newBlankTestClient()
+	client.TestCommand = "make test"
+	dir, _ := os.Getwd()
+	rc := client.shellRc("")
+	expected := fmt.Sprintf("rerun() { (cd %v && %v -c 'make test'); }\n", shellQuote(dir), shellQuote(commandShell()))
+	assertEqual(t, "rerun", true, strings.Contains(rc, expected))
+	assertEqual(t, "shell", true, strings.HasSuffix(testCommand("make test").Path, "sh"))
+}
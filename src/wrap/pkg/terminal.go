@@ -1,111 +1,386 @@
 package wrap
 
 import (
+	"bytes"
 	"github.com/creack/pty"
 	"github.com/layer-devops/wrap.sh/src/protocol"
 	"github.com/pkg/errors"
 	"io"
+	"math"
 	"os"
-	"os/exec"
 	"sync"
+	"sync/atomic"
 	"syscall"
-	"unsafe"
+	"time"
 )
 
+// output beyond this is dropped (oldest first) while the server connection is down
+const maxBufferedTerminalOutput = 1024 * 1024
+
+// identifies a terminal session, see protocol.TerminalData
+type terminalKey struct {
+	listenerId uint32
+	sessionId  uint32
+}
+
 type terminal struct {
+	key    terminalKey
 	close  func()
 	closer sync.Once
-	closed bool
+	// set once the session is closing, read by the goroutines serving it
+	closed int32
+	// the pty's master side, and the tty its shell uses
 	bash   *os.File
+	tty    *os.File
+	exited chan struct{}
 	stdin  io.Reader
 	stdout io.Writer
+
+	// output waiting to be sent to the wrap.sh server
+	outputMutex sync.Mutex
+	output      bytes.Buffer
+	outputReady chan struct{}
+
+	// path of the shell running in the pty
+	shell string
+	// removes the shell's rc file
+	cleanup func()
+
+	// nil unless sessions are recorded
+	recording *recording
+	// masks secrets in the output before it's recorded or sent
+	redaction *timedRedactingStream
+	// masks secrets in the input before it's recorded, nil unless input is recorded
+	inputRedaction *timedRedactingStream
+}
+
+func (t *terminal) bufferOutput(b []byte) {
+	t.outputMutex.Lock()
+	t.output.Write(b)
+	if overflow := t.output.Len() - maxBufferedTerminalOutput; overflow > 0 {
+		t.output.Next(overflow)
+	}
+	t.outputMutex.Unlock()
+	select {
+	case t.outputReady <- struct{}{}:
+	default:
+	}
+}
+
+func (t *terminal) takeOutput() []byte {
+	t.outputMutex.Lock()
+	defer t.outputMutex.Unlock()
+	b := make([]byte, t.output.Len())
+	copy(b, t.output.Bytes())
+	t.output.Reset()
+	return b
+}
+
+/*
+Sends buffered terminal output to the wrap.sh server. Sending blocks
+while reconnecting, so the shell keeps running and its output piles up
+in the buffer until the connection is back.
+*/
+func (client *Client) sendTerminalOutput(t *terminal) {
+	for range t.outputReady {
+		b := t.takeOutput()
+		if len(b) == 0 {
+			continue
+		}
+		err := client.send(&protocol.MessageFromWrapClient{
+			Spec: &protocol.MessageFromWrapClient_TerminalData{
+				TerminalData: &protocol.TerminalData{
+					Data:      b,
+					SessionId: t.key.sessionId,
+				},
+			},
+			// only the listener which opened the session sees its output
+			ListenerId: t.key.listenerId,
+		})
+		if err != nil {
+			if !t.isClosed() && !client.isClosed() {
+				client.Log(errors.Wrap(err, "send terminal data").Error())
+			}
+			return
+		}
+	}
+}
+
+func (t *terminal) isClosed() bool {
+	return atomic.LoadInt32(&t.closed) != 0
 }
 
 /*
 Starts a local bash shell for running commands sent
 by Wrap Dashboard users, sends any output to the wrap.sh server.
 */
-func (client *Client) startPty() {
-	bash := exec.Command("bash")
+func (client *Client) openTerminal(key terminalKey) (*terminal, error) {
+	bash, cleanup, err := client.shellCommand()
+	if err != nil {
+		return nil, err
+	}
 
-	t := &terminal{}
-	client.terminal = t
+	t := &terminal{
+		key:         key,
+		shell:       bash.Path,
+		outputReady: make(chan struct{}, 1),
+	}
+	t.redaction = newTimedRedactingStream(client.redactor, func(b []byte) {
+		if t.recording != nil {
+			t.recording.output(b)
+		}
+		t.bufferOutput(b)
+	})
 
 	// Prepare teardown function
 	t.close = func() {
-		t.closed = true
+		atomic.StoreInt32(&t.closed, 1)
 		err := bash.Process.Kill()
 		if err != nil {
-			panic(errors.Wrap(err, "close pty"))
+			client.debugLog("close pty: %v", err)
 		}
 	}
 
-	// Allocate a terminal for this channel
-	bashf, err := pty.Start(bash)
+	// Allocate a terminal for this channel. The tty is kept open, so that reruns can be attached to it
+	bashf, tty, err := pty.Open()
 	if err != nil {
-		panic(errors.Wrap(err, "open pty"))
+		cleanup()
+		return nil, errors.Wrap(err, "open pty")
 	}
-
+	bash.Stdin, bash.Stdout, bash.Stderr = tty, tty, tty
+	bash.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true}
+	err = bash.Start()
+	if err != nil {
+		cleanup()
+		_ = bashf.Close()
+		_ = tty.Close()
+		return nil, errors.Wrap(err, "start shell")
+	}
+	t.cleanup = cleanup
 	t.bash = bashf
+	t.tty = tty
+	t.exited = make(chan struct{})
+	client.startRecording(t)
+
+	client.terminalsMutex.Lock()
+	if client.terminals == nil {
+		client.terminals = map[terminalKey]*terminal{}
+	}
+	client.terminals[key] = t
+	client.terminalsMutex.Unlock()
+	client.terminalsDone.Add(1)
 
 	// send output to wrap.sh server
+	go client.sendTerminalOutput(t)
+	readDone := make(chan struct{})
+	go func() {
+		client.readTerminal(t)
+		close(readDone)
+	}()
+	go func() {
+		_ = bash.Wait()
+		close(t.exited)
+		_ = tty.Close()
+		select {
+		case <-readDone:
+		case <-time.After(time.Second):
+			// e.g. a rerun still has the tty open, stop reading anyway
+			_ = t.bash.Close()
+		}
+	}()
+	return t, nil
+}
+
+/* starts the terminal shared by everyone on the dashboard */
+func (client *Client) startPty() {
+	_, err := client.openTerminal(terminalKey{})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (client *Client) readTerminal(t *terminal) {
+	defer client.removeTerminal(t)
 	var buf [1024]byte
 	for {
-		if t.closed {
+		if t.isClosed() {
 			return
 		}
-		n, err := bashf.Read(buf[:])
+		n, err := t.bash.Read(buf[:])
 		if err != nil {
-			client.Log(errors.Wrap(err, "terminal output").Error())
-			t.closer.Do(t.close)
+			// the shell exited, or the session was closed
+			client.debugLog("terminal session %v: %v", t.key.sessionId, err)
 			return
 		}
 		if n == 0 {
 			continue
 		}
-		err = client.send(&protocol.MessageFromWrapClient{
-			Spec: &protocol.MessageFromWrapClient_TerminalData{
-				TerminalData: &protocol.TerminalData{
-					Data: buf[:n],
-				},
-			}})
-		if err != nil {
-			panic(errors.Wrap(err, "send terminal data"))
+		t.redaction.write(buf[:n])
+	}
+}
+
+/* cleans up after a terminal's shell has exited, without affecting other sessions */
+func (client *Client) removeTerminal(t *terminal) {
+	defer client.terminalsDone.Done()
+	t.closer.Do(t.close)
+	<-t.exited
+	_ = t.bash.Close()
+	t.cleanup()
+	t.redaction.close()
+	if t.inputRedaction != nil {
+		t.inputRedaction.close()
+	}
+	close(t.outputReady)
+	// before the client finishes closing, so the recording can still be uploaded
+	client.finishRecording(t)
+	client.terminalsMutex.Lock()
+	if client.terminals[t.key] == t {
+		delete(client.terminals, t.key)
+	}
+	client.terminalsMutex.Unlock()
+	if client.isClosed() {
+		return
+	}
+	client.Log("Terminal session %v was closed.", t.key.sessionId)
+	err := client.send(&protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_TerminalClose{
+			TerminalClose: &protocol.TerminalClose{
+				SessionId: t.key.sessionId,
+			},
+		},
+		ListenerId: t.key.listenerId,
+	})
+	if err != nil {
+		client.debugLog(errors.Wrap(err, "send terminal close").Error())
+	}
+}
+
+/* returns the open terminal session a message from the given listener is meant for */
+func (client *Client) getTerminal(sessionId uint32, listenerId uint32) *terminal {
+	key := terminalKey{listenerId: listenerId, sessionId: sessionId}
+	if sessionId == 0 {
+		key.listenerId = 0
+	}
+	client.terminalsMutex.Lock()
+	defer client.terminalsMutex.Unlock()
+	t := client.terminals[key]
+	if t == nil || t.isClosed() {
+		return nil
+	}
+	return t
+}
+
+/* closes every terminal session, or those opened by the given listener */
+func (client *Client) closeTerminals(all bool, listenerId uint32) {
+	client.terminalsMutex.Lock()
+	var closing []*terminal
+	for key, t := range client.terminals {
+		if all || (key.sessionId != 0 && key.listenerId == listenerId) {
+			closing = append(closing, t)
 		}
 	}
+	client.terminalsMutex.Unlock()
+	for _, t := range closing {
+		t.closer.Do(t.close)
+	}
 }
 
-func (client *Client) handleTerminalWrite(msg *protocol.TerminalData) error {
-	if client.terminal == nil || client.terminal.closed {
+func (client *Client) handleTerminalOpen(msg *protocol.TerminalOpen, listenerId uint32) error {
+	sessionId := msg.GetSessionId()
+	if client.getTerminal(sessionId, listenerId) != nil {
+		return errors.Errorf("terminal session %v is already open", sessionId)
+	}
+	key := terminalKey{listenerId: listenerId, sessionId: sessionId}
+	if sessionId == 0 {
+		// the shared terminal is opened with the debug session, this reopens it after its shell exited
+		key.listenerId = 0
+	}
+	_, err := client.openTerminal(key)
+	if err != nil {
+		return err
+	}
+	client.debugLog("opened terminal session %v for listener %v", sessionId, listenerId)
+	return nil
+}
+
+func (client *Client) handleTerminalClose(msg *protocol.TerminalClose, listenerId uint32) error {
+	sessionId := msg.GetSessionId()
+	if sessionId == 0 {
+		client.closeTerminals(false, listenerId)
+		return nil
+	}
+	t := client.getTerminal(sessionId, listenerId)
+	if t == nil {
+		client.debugLog("close attempt for non-open terminal %v", sessionId)
+		return nil
+	}
+	t.closer.Do(t.close)
+	return nil
+}
+
+func (client *Client) handleTerminalWrite(msg *protocol.TerminalData, listenerId uint32) error {
+	t := client.getTerminal(msg.GetSessionId(), listenerId)
+	if t == nil {
 		client.Log("write attempt for non-open terminal")
 		return nil
 	}
 	d := msg.GetData()
-	_, err := client.terminal.bash.Write(d)
+	if t.inputRedaction != nil {
+		t.inputRedaction.write(d)
+	}
+	_, err := t.bash.Write(d)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// terminalSize stores the Height and Width of a terminal.
-type terminalSize struct {
-	Height uint16
-	Width  uint16
-	x      uint16 // unused
-	y      uint16 // unused
+// used for the rows when a legacy TerminalWidth arrives before any TerminalResize
+const defaultTerminalRows = 40
+
+/* limits a terminal dimension from the protocol to what a pty can have, instead of letting it wrap around */
+func clampTerminalSize(n uint32) uint16 {
+	if n > math.MaxUint16 {
+		return math.MaxUint16
+	}
+	return uint16(n)
 }
 
-func setTerminalSize(fd uintptr, w, h uint32) {
-	ws := &terminalSize{Width: uint16(w), Height: uint16(h)}
-	syscall.Syscall(syscall.SYS_IOCTL, fd, uintptr(syscall.TIOCSWINSZ), uintptr(unsafe.Pointer(ws)))
+func setTerminalSize(f *os.File, rows, cols uint16) error {
+	return pty.Setsize(f, &pty.Winsize{Rows: rows, Cols: cols})
 }
 
-func (client *Client) handleTerminalWidth(msg *protocol.TerminalWidth) error {
-	if client.terminal == nil || client.terminal.closed {
+func (client *Client) resizeTerminal(t *terminal, rows uint16, cols uint16) error {
+	err := setTerminalSize(t.bash, rows, cols)
+	if err == nil && t.recording != nil {
+		t.recording.resize(uint32(rows), uint32(cols))
+	}
+	return err
+}
+
+func (client *Client) handleTerminalResize(msg *protocol.TerminalResize, listenerId uint32) error {
+	t := client.getTerminal(msg.GetSessionId(), listenerId)
+	if t == nil {
 		client.debugLog("resize attempt for non-open terminal")
 		return nil
 	}
-	setTerminalSize(client.terminal.bash.Fd(), msg.GetNewWidth(), 40)
-	return nil
+	if msg.GetRows() == 0 || msg.GetCols() == 0 {
+		return errors.Errorf("invalid terminal size %vx%v", msg.GetCols(), msg.GetRows())
+	}
+	rows, cols := clampTerminalSize(msg.GetRows()), clampTerminalSize(msg.GetCols())
+	return errors.Wrap(client.resizeTerminal(t, rows, cols), "resize pty")
+}
+
+/* kept for older dashboards, which only send the width */
+func (client *Client) handleTerminalWidth(msg *protocol.TerminalWidth, listenerId uint32) error {
+	t := client.getTerminal(msg.GetSessionId(), listenerId)
+	if t == nil {
+		client.debugLog("resize attempt for non-open terminal")
+		return nil
+	}
+	rows := uint16(defaultTerminalRows)
+	if current, err := pty.GetsizeFull(t.bash); err == nil && current.Rows > 0 {
+		rows = current.Rows
+	}
+	return errors.Wrap(client.resizeTerminal(t, rows, clampTerminalSize(msg.GetNewWidth())), "resize pty")
 }
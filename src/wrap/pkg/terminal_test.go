@@ -0,0 +1,128 @@
+package wrap
+
+import (
+	"github.com/creack/pty"
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+/* connects the client to a test server, returning the messages the client sends it and a function closing both */
+func connectTestClient(t *testing.T, c *Client) (<-chan *protocol.MessageFromWrapClient, func()) {
+	messages := make(chan *protocol.MessageFromWrapClient, 1000)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			_, b, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			msg := &protocol.MessageFromWrapClient{}
+			if proto.Unmarshal(b, msg) == nil {
+				messages <- msg
+			}
+		}
+	}))
+	c.closedChan = make(chan struct{}, 1)
+	c.wsChanged = sync.NewCond(&c.wsWriteMutex)
+	c.ReconnectTimeoutMinutes = -1
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+	c.setConnection(ws)
+	return messages, func() {
+		c.close()
+		server.Close()
+	}
+}
+
+/* returns the first message the client sends which matches */
+func waitForMessage(t *testing.T, messages <-chan *protocol.MessageFromWrapClient,
+	match func(*protocol.MessageFromWrapClient) bool) *protocol.MessageFromWrapClient {
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case msg := <-messages:
+			if match(msg) {
+				return msg
+			}
+		case <-timeout:
+			t.Fatal("Timed out waiting for a message from the client")
+		}
+	}
+}
+
+func TestSetTerminalSize(t *testing.T) {
+	ptmx, tty, err := pty.Open()
+	if err != nil {
+		t.Skip("no pty available:", err)
+	}
+	defer ptmx.Close()
+	defer tty.Close()
+	err = setTerminalSize(ptmx, 50, 120)
+	assertNil(t, "error", err)
+	size, err := pty.GetsizeFull(tty)
+	assertNil(t, "error", err)
+	assertEqual(t, "rows", uint16(50), size.Rows)
+	assertEqual(t, "cols", uint16(120), size.Cols)
+
+}
+
+func TestResizeTerminalClamps(t *testing.T) {
+	c := newBlankTestClient()
+	_, done := connectTestClient(t, c)
+	defer done()
+	term, err := c.openTerminal(terminalKey{})
+	if err != nil {
+		t.Skip("no terminal available:", err)
+	}
+	// too big for a pty, rather than wrapping around to 4
+	err = c.handleTerminalResize(&protocol.TerminalResize{Rows: 50, Cols: 65540}, 0)
+	assertNil(t, "error", err)
+	size, err := pty.GetsizeFull(term.bash)
+	assertNil(t, "error", err)
+	assertEqual(t, "rows", uint16(50), size.Rows)
+	assertEqual(t, "cols", uint16(65535), size.Cols)
+}
+
+func TestReopenSharedTerminal(t *testing.T) {
+	c := newBlankTestClient()
+	messages, done := connectTestClient(t, c)
+	defer done()
+	_, err := c.openTerminal(terminalKey{})
+	if err != nil {
+		t.Skip("no terminal available:", err)
+	}
+	err = c.HandleMessage(&protocol.MessageToWrapClient{
+		Spec: &protocol.MessageToWrapClient_TerminalWrite{
+			TerminalWrite: &protocol.TerminalData{Data: []byte("exit\n")},
+		},
+	})
+	assertNil(t, "error", err)
+	waitForMessage(t, messages, func(msg *protocol.MessageFromWrapClient) bool {
+		return msg.GetTerminalClose() != nil
+	})
+	assertNil(t, "terminal", c.getTerminal(0, 0))
+
+	err = c.HandleMessage(&protocol.MessageToWrapClient{
+		Spec: &protocol.MessageToWrapClient_TerminalOpen{
+			TerminalOpen: &protocol.TerminalOpen{},
+		},
+		ListenerId: 1,
+	})
+	assertNil(t, "error", err)
+	assertNotNil(t, "terminal", c.getTerminal(0, 1))
+}
@@ -0,0 +1,115 @@
+package wrap
+
+import (
+	"fmt"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"github.com/layer-devops/wrap.sh/src/wrap/report"
+	"github.com/pkg/errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+/* makes relative paths absolute if they exist, so the dashboard can open them */
+func resolveTestFile(file string) string {
+	if file == "" || filepath.IsAbs(file) {
+		return file
+	}
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return file
+	}
+	if _, err := os.Stat(abs); err != nil {
+		return file
+	}
+	return abs
+}
+
+/*
+Parses the test reports matching the configured globs, also returning the
+names of the tests which passed. Reports which weren't written since the
+given time are left over from earlier runs, and skipped.
+*/
+func (client *Client) collectTestReport(since time.Time) (*protocol.TestReport, map[string]bool) {
+	if len(client.TestReports) == 0 {
+		return nil, nil
+	}
+	result := &protocol.TestReport{}
+	passed := map[string]bool{}
+	formats := make([]string, 0, len(client.TestReports))
+	for format := range client.TestReports {
+		formats = append(formats, string(format))
+	}
+	sort.Strings(formats)
+	for _, format := range formats {
+		for _, pattern := range client.TestReports[report.Format(format)] {
+			paths, err := report.Glob(pattern)
+			if err != nil {
+				result.Error = append(result.Error, fmt.Sprintf("%v: %v", pattern, err))
+				continue
+			}
+			for _, path := range paths {
+				info, err := os.Stat(path)
+				if err != nil || info.ModTime().Before(since) {
+					continue
+				}
+				r, err := report.ParseFile(report.Format(format), path)
+				if err != nil {
+					result.Error = append(result.Error, errors.Wrap(err, path).Error())
+					continue
+				}
+				result.ReportPath = append(result.ReportPath, resolveTestFile(path))
+				result.Total += uint32(len(r.Tests))
+				result.Skipped += uint32(r.Skipped())
+				for _, test := range r.Tests {
+					if !test.Failed && !test.Skipped {
+						passed[testName(test.Suite, test.Name)] = true
+					}
+				}
+				for _, test := range r.Failures() {
+					result.Failed++
+					result.Failure = append(result.Failure, &protocol.TestFailure{
+						Name:       test.Name,
+						Suite:      test.Suite,
+						Message:    test.Message,
+						Details:    test.Details,
+						File:       resolveTestFile(test.File),
+						Line:       uint32(test.Line),
+						DurationMs: uint64(test.Duration / time.Millisecond),
+					})
+				}
+			}
+		}
+	}
+	for _, err := range result.Error {
+		client.Log("Could not read test report: %v", err)
+	}
+	return result, passed
+}
+
+func (client *Client) logTestReport(r *protocol.TestReport) {
+	if r == nil || len(r.ReportPath) == 0 {
+		return
+	}
+	client.Log("%v of %v test(s) failed:", r.Failed, r.Total)
+	for _, failure := range r.Failure {
+		location := ""
+		if failure.File != "" {
+			location = fmt.Sprintf(" (%v:%v)", failure.File, failure.Line)
+		}
+		client.Log("  %v%v: %v", failure.Name, location, failure.Message)
+	}
+}
+
+func (client *Client) sendTestReport() error {
+	if client.testReport == nil {
+		return nil
+	}
+	err := client.send(&protocol.MessageFromWrapClient{
+		Spec: &protocol.MessageFromWrapClient_TestReport{
+			TestReport: client.testReport,
+		},
+	})
+	return errors.Wrap(err, "send test report")
+}
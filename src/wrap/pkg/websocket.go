@@ -8,16 +8,62 @@ import (
 	"github.com/pkg/errors"
 	"io"
 	"log"
+	"net"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
 )
 
-func (client *Client) read() ([]byte, error) {
+const (
+	// how often the connection to the server is checked
+	pingInterval = 30 * time.Second
+	// the connection is considered dropped if nothing is heard from the server for this long
+	pongWait = 2 * pingInterval
+
+	reconnectMinBackoff            = time.Second
+	reconnectMaxBackoff            = 30 * time.Second
+	defaultReconnectTimeoutMinutes = 5
+)
+
+var authenticationError = errors.New("could not authenticate with wrap.sh server")
+
+// connects to the wrap.sh server, replaced in tests
+var dialer = websocket.DefaultDialer
+
+/*
+Resolves a user-provided server address into a websocket URL.
+Addresses without a scheme default to wss, and addresses without
+a path use the standard wrap.sh server path.
+*/
+func ResolveServerLocation(address string) (string, error) {
+	if !strings.Contains(address, "://") {
+		address = "wss://" + address
+	}
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", errors.Wrap(err, "parse server address")
+	}
+	if u.Scheme != "ws" && u.Scheme != "wss" {
+		return "", fmt.Errorf("unsupported scheme %q, expected ws or wss", u.Scheme)
+	}
+	if u.Host == "" {
+		return "", errors.New("no host in server address")
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = "/" + protocol.WrapServerPath
+	}
+	return u.String(), nil
+}
+
+func (client *Client) read(ws *websocket.Conn) ([]byte, error) {
 	client.recvBuf.Reset()
-	messageType, reader, err := client.ws.NextReader()
+	messageType, reader, err := ws.NextReader()
 	if err != nil {
 		return nil, err
 	}
+	ws.SetReadDeadline(time.Now().Add(pongWait))
 	if messageType != websocket.BinaryMessage {
 		return nil, fmt.Errorf("unexpected message type: %v", messageType)
 	}
@@ -25,19 +71,72 @@ func (client *Client) read() ([]byte, error) {
 	return client.recvBuf.Bytes(), err
 }
 
+func writeMessage(ws *websocket.Conn, b []byte) error {
+	ws.SetWriteDeadline(time.Now().Add(time.Second * 10))
+	return ws.WriteMessage(websocket.BinaryMessage, b)
+}
+
+/*
+Sends a message to the server. If the connection is down, this
+blocks until the client has reconnected or is closed.
+*/
 func (client *Client) send(msg *protocol.MessageFromWrapClient) error {
-	client.wsWriteMutex.Lock()
-	defer client.wsWriteMutex.Unlock()
 	b, err := proto.Marshal(msg)
 	if err != nil {
 		return errors.Wrap(err, "could not encode message to server")
 	}
-	client.ws.SetWriteDeadline(time.Now().Add(time.Second * 10))
-	err = client.ws.WriteMessage(websocket.BinaryMessage, b)
-	return errors.Wrap(err, "could not write message to server")
+	client.wsWriteMutex.Lock()
+	defer client.wsWriteMutex.Unlock()
+	for {
+		for client.ws == nil {
+			if client.isClosed() {
+				return errors.New("could not write message to server: connection closed")
+			}
+			client.wsChanged.Wait()
+		}
+		err = writeMessage(client.ws, b)
+		if err == nil || client.isClosed() || client.ReconnectTimeoutMinutes < 0 {
+			return errors.Wrap(err, "could not write message to server")
+		}
+		// make sure the listener notices the broken connection, then retry once it has reconnected
+		client.debugLog("write failed, waiting for reconnect: %v", err)
+		broken := client.ws
+		_ = broken.Close()
+		for client.ws == broken && !client.isClosed() {
+			client.wsChanged.Wait()
+		}
+	}
 }
 
-func (client *Client) connectToServer() error {
+func (client *Client) setConnection(ws *websocket.Conn) {
+	client.wsWriteMutex.Lock()
+	defer client.wsWriteMutex.Unlock()
+	if client.ws != nil && client.ws != ws {
+		_ = client.ws.Close()
+	}
+	client.ws = ws
+	client.wsChanged.Broadcast()
+}
+
+/* pings the server periodically so that silently dropped connections are noticed */
+func keepAlive(ws *websocket.Conn) {
+	ws.SetReadDeadline(time.Now().Add(pongWait))
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(pongWait))
+	})
+	go func() {
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			err := ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(time.Second*10))
+			if err != nil {
+				return
+			}
+		}
+	}()
+}
+
+func (client *Client) dial() (*websocket.Conn, error) {
 	client.debugLog("connecting to %s", client.WebsocketLocation)
 	if client.LogDebug {
 		if client.Token == "" {
@@ -48,40 +147,198 @@ func (client *Client) connectToServer() error {
 	}
 	header := http.Header{}
 	header.Set(protocol.WrapAuthHeaderName, client.Token)
-	ws, response, err := websocket.DefaultDialer.Dial(client.WebsocketLocation, header)
+	ws, response, err := dialer.Dial(client.WebsocketLocation, header)
 	if err != nil {
 		if response != nil {
 			client.debugLog("got %v for status code while dialing", response.StatusCode)
 		}
 		if response != nil && response.StatusCode == http.StatusNotFound {
-			return errors.New("could not authenticate with wrap.sh server")
+			return nil, authenticationError
+		}
+		return nil, errors.Wrap(err, "dial wrap.sh server")
+	}
+	keepAlive(ws)
+	return ws, nil
+}
+
+func (client *Client) connectToServer() error {
+	ws, err := client.dial()
+	if err != nil {
+		return err
+	}
+	client.setConnection(ws)
+	err = client.sendHello()
+	if err != nil {
+		return err
+	}
+	client.commandOutputSeq, err = client.sendCommandOutput(0)
+	if err != nil {
+		return err
+	}
+	err = client.sendTestReport()
+	if err != nil {
+		return err
+	}
+	return client.sendAttempts()
+}
+
+/*
+Re-establishes a dropped connection with exponential backoff,
+resuming the existing debug session so dashboards stay attached.
+*/
+func (client *Client) reconnect() (*websocket.Conn, error) {
+	client.setConnection(nil)
+	timeoutMinutes := client.ReconnectTimeoutMinutes
+	if timeoutMinutes == 0 {
+		timeoutMinutes = defaultReconnectTimeoutMinutes
+	}
+	deadline := time.Now().Add(time.Duration(timeoutMinutes) * time.Minute)
+	if sessionId, _ := client.session(); sessionId == "" {
+		// the server never answered our hello, there's no session to resume
+		client.Log("The connection dropped before the debug session was set up, a new one will be started.")
+	}
+	backoff := reconnectMinBackoff
+	for attempt := 1; ; attempt++ {
+		if client.isClosed() {
+			return nil, errors.New("client closed")
+		}
+		ws, err := client.dial()
+		if err == nil {
+			// the hello has to be the first message on the new connection,
+			// so it is written before anything queued up in send()
+			var b []byte
+			b, err = proto.Marshal(client.resumeHello())
+			if err == nil {
+				err = writeMessage(ws, b)
+			}
+			if err == nil {
+				client.setConnection(ws)
+				return ws, nil
+			}
+			_ = ws.Close()
+		}
+		if err == authenticationError {
+			return nil, err
+		}
+		if time.Now().Add(backoff).After(deadline) {
+			return nil, errors.Wrapf(err, "gave up after %v attempts", attempt)
+		}
+		client.debugLog("reconnect attempt %v failed, retrying in %v: %v", attempt, backoff, err)
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > reconnectMaxBackoff {
+			backoff = reconnectMaxBackoff
+		}
+	}
+}
+
+/* returns whether the given read error is worth reconnecting over */
+func (client *Client) shouldReconnect(err error) bool {
+	if client.ReconnectTimeoutMinutes < 0 {
+		return false
+	}
+	cause := errors.Cause(err)
+	if wsErr, ok := cause.(*websocket.CloseError); ok {
+		// the server ended the session on purpose
+		return wsErr.Code != websocket.CloseNormalClosure
+	}
+	_, isNetErr := cause.(net.Error)
+	return isNetErr || cause == io.EOF || cause == io.ErrUnexpectedEOF
+}
+
+/*
+Messages read from the server, waiting to be handled. Replies can block in
+send() until the client has reconnected, which is up to the goroutine reading
+from the server, so messages are handled by another goroutine and queued for
+it without a limit.
+*/
+type messageQueue struct {
+	mutex    sync.Mutex
+	changed  *sync.Cond
+	messages []*protocol.MessageToWrapClient
+	closed   bool
+}
+
+func newMessageQueue() *messageQueue {
+	q := &messageQueue{}
+	q.changed = sync.NewCond(&q.mutex)
+	return q
+}
+
+func (q *messageQueue) push(msg *protocol.MessageToWrapClient) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.messages = append(q.messages, msg)
+	q.changed.Signal()
+}
+
+/* returns the next message, waiting for one, or nil once the queue is closed and empty */
+func (q *messageQueue) pop() *protocol.MessageToWrapClient {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	for len(q.messages) == 0 {
+		if q.closed {
+			return nil
+		}
+		q.changed.Wait()
+	}
+	msg := q.messages[0]
+	q.messages[0] = nil
+	q.messages = q.messages[1:]
+	return msg
+}
+
+func (q *messageQueue) close() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.closed = true
+	q.changed.Signal()
+}
+
+/* handles the server's messages in the order they were read */
+func (client *Client) handleMessages(queue *messageQueue) {
+	for msg := queue.pop(); msg != nil; msg = queue.pop() {
+		if client.isClosed() {
+			// nothing can be sent back anymore, drop what's left
+			continue
+		}
+		err := client.HandleMessage(msg)
+		if err != nil {
+			client.debugLog(errors.Wrap(err, "handle message").Error())
 		}
-		return errors.Wrap(err, "dial wrap.sh server")
 	}
-	client.ws = ws
-	return client.sendHello()
 }
 
 func (client *Client) listenServer() {
+	queue := newMessageQueue()
+	defer queue.close()
+	go client.handleMessages(queue)
+	ws := client.ws
 	for {
-		if client.closed {
+		if client.isClosed() {
 			return
 		}
-		b, err := client.read()
+		b, err := client.read(ws)
 		if err != nil {
-			if errors.Cause(err) == io.EOF {
-				client.close()
+			if client.isClosed() {
+				return
+			}
+			if client.shouldReconnect(err) {
+				client.Log("Lost connection to the wrap.sh server (%v), reconnecting...", err)
+				ws, err = client.reconnect()
+				if err == nil {
+					continue
+				}
+				client.Log(errors.Wrap(err, "could not reconnect").Error())
 			} else if wsErr, ok := errors.Cause(err).(*websocket.CloseError); ok {
-				if wsErr.Code == websocket.CloseGoingAway || wsErr.Code == websocket.CloseAbnormalClosure {
-					client.close()
-				} else {
+				if !websocket.IsCloseError(wsErr, websocket.CloseNormalClosure,
+					websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 					client.Log(errors.Wrap(err, "connection error").Error())
-					client.close()
 				}
-			} else {
+			} else if errors.Cause(err) != io.EOF {
 				client.Log(errors.Wrap(err, "invalid message data received").Error())
-				client.close()
 			}
+			client.close()
 			return
 		}
 		msg := &protocol.MessageToWrapClient{}
@@ -90,6 +347,10 @@ func (client *Client) listenServer() {
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = client.HandleMessage(msg)
+		if response := msg.GetHelloResponse(); response.GetSessionId() != "" {
+			// kept right away, so that reconnecting resumes the session even if it's still queued
+			client.setSession(response.GetSessionId(), response.GetResumeToken())
+		}
+		queue.push(msg)
 	}
 }
@@ -0,0 +1,162 @@
+package wrap
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"github.com/layer-devops/wrap.sh/src/protocol"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestResolveServerLocation(t *testing.T) {
+	for address, expected := range map[string]string{
+		"wrap.example.com":              "wss://wrap.example.com/wrap",
+		"ws://localhost:8080":           "ws://localhost:8080/wrap",
+		"wss://wrap.example.com/":       "wss://wrap.example.com/wrap",
+		"wss://wrap.example.com/a/wrap": "wss://wrap.example.com/a/wrap",
+	} {
+		loc, err := ResolveServerLocation(address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, "location for "+address, expected, loc)
+	}
+	for _, address := range []string{"https://wrap.example.com", "ws://", "ws://%zz"} {
+		_, err := ResolveServerLocation(address)
+		assertNotNil(t, "error for "+address, err)
+	}
+}
+
+/* a connection whose writes fail once failing is set */
+type flakyConn struct {
+	net.Conn
+	failing int32
+}
+
+func (c *flakyConn) Write(b []byte) (int, error) {
+	if atomic.LoadInt32(&c.failing) != 0 {
+		return 0, &net.OpError{Op: "write", Net: "tcp", Err: syscall.EPIPE}
+	}
+	return c.Conn.Write(b)
+}
+
+func TestReconnectWhileReplying(t *testing.T) {
+	type received struct {
+		conn int
+		msg  *protocol.MessageFromWrapClient
+	}
+	messages := make(chan received, 100)
+	serverConns := make(chan *websocket.Conn, 10)
+	connCount := int32(0)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		n := int(atomic.AddInt32(&connCount, 1))
+		serverConns <- conn
+		for {
+			_, b, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			msg := &protocol.MessageFromWrapClient{}
+			if proto.Unmarshal(b, msg) == nil {
+				messages <- received{n, msg}
+			}
+		}
+	}))
+	defer server.Close()
+
+	var clientConnsMutex sync.Mutex
+	var clientConns []*flakyConn
+	oldDialer := dialer
+	defer func() { dialer = oldDialer }()
+	dialer = &websocket.Dialer{NetDial: func(network, addr string) (net.Conn, error) {
+		conn, err := net.Dial(network, addr)
+		if err != nil {
+			return nil, err
+		}
+		clientConnsMutex.Lock()
+		defer clientConnsMutex.Unlock()
+		clientConns = append(clientConns, &flakyConn{Conn: conn})
+		return clientConns[len(clientConns)-1], nil
+	}}
+
+	c := newBlankTestClient()
+	c.closedChan = make(chan struct{}, 1)
+	c.wsChanged = sync.NewCond(&c.wsWriteMutex)
+	c.ReconnectTimeoutMinutes = 1
+	c.WebsocketLocation = "ws" + strings.TrimPrefix(server.URL, "http")
+	c.hello = &protocol.Hello{}
+	c.setSession("session", "token")
+	ws, err := c.dial()
+	assertNil(t, "dial error", err)
+	c.setConnection(ws)
+	go c.listenServer()
+	defer c.close()
+
+	f := makeTempFile(t, "abc")
+	defer f.Remove()
+	first := <-serverConns
+	// the reply to the request fails, the client has to reconnect to send it
+	clientConnsMutex.Lock()
+	atomic.StoreInt32(&clientConns[0].failing, 1)
+	clientConnsMutex.Unlock()
+	b, err := proto.Marshal(&protocol.MessageToWrapClient{
+		Spec: &protocol.MessageToWrapClient_FileRead{FileRead: &protocol.FileRead{Path: f.Path}},
+	})
+	assertNil(t, "marshal error", err)
+	assertNil(t, "write error", first.WriteMessage(websocket.BinaryMessage, b))
+
+	timeout := time.After(10 * time.Second)
+	var got []received
+	for len(got) < 2 {
+		select {
+		case r := <-messages:
+			got = append(got, r)
+		case <-timeout:
+			t.Fatal("Timed out waiting for the client to reconnect and reply")
+		}
+	}
+	assertEqual(t, "hello connection", 2, got[0].conn)
+	assertEqual(t, "resume token", "token", got[0].msg.GetHello().GetResumeToken())
+	assertEqual(t, "reply connection", 2, got[1].conn)
+	assertEqual(t, "reply data", "abc", string(got[1].msg.GetFileReadResult().GetData()))
+}
+
+func TestClosedClientDropsMessages(t *testing.T) {
+	c := newBlankTestClient()
+	c.closedChan = make(chan struct{}, 1)
+	c.wsChanged = sync.NewCond(&c.wsWriteMutex)
+	c.close()
+	f := makeTempFile(t, "abc")
+	defer f.Remove()
+	// replies can't be sent anymore, which mustn't bring the client down
+	assertNil(t, "err", c.handleFileRead(&protocol.FileRead{Path: f.Path}, 1))
+
+	queue := newMessageQueue()
+	queue.push(&protocol.MessageToWrapClient{
+		Spec: &protocol.MessageToWrapClient_FileRead{FileRead: &protocol.FileRead{Path: f.Path}},
+	})
+	queue.close()
+	done := make(chan struct{})
+	go func() {
+		c.handleMessages(queue)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the queued messages to be dropped")
+	}
+}
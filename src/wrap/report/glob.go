@@ -0,0 +1,74 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func hasWildcard(s string) bool {
+	return strings.ContainsAny(s, "*?[")
+}
+
+/* returns the leading directories of pattern which contain no wildcards */
+func staticPrefix(pattern string) string {
+	segments := strings.Split(pattern, "/")
+	i := 0
+	for i < len(segments)-1 && !hasWildcard(segments[i]) {
+		i++
+	}
+	prefix := strings.Join(segments[:i], "/")
+	if prefix == "" && strings.HasPrefix(pattern, "/") {
+		return "/"
+	}
+	if prefix == "" {
+		return "."
+	}
+	return prefix
+}
+
+func matchSegments(pattern []string, path []string) bool {
+	if len(pattern) == 0 {
+		return len(path) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(path); i++ {
+			if matchSegments(pattern[1:], path[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(path) == 0 {
+		return false
+	}
+	ok, err := filepath.Match(pattern[0], path[0])
+	return err == nil && ok && matchSegments(pattern[1:], path[1:])
+}
+
+/*
+Returns the files matching pattern. In addition to the filepath.Match
+syntax, a "**" path segment matches any number of directories.
+*/
+func Glob(pattern string) ([]string, error) {
+	pattern = filepath.Clean(pattern)
+	if !strings.Contains(pattern, "**") {
+		return filepath.Glob(pattern)
+	}
+	patternSegments := strings.Split(pattern, "/")
+	var matches []string
+	err := filepath.Walk(staticPrefix(pattern), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// e.g. permission errors, skip what we can't read
+			if info != nil && info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.IsDir() && matchSegments(patternSegments, strings.Split(path, "/")) {
+			matches = append(matches, path)
+		}
+		return nil
+	})
+	return matches, err
+}
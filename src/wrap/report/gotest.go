@@ -0,0 +1,72 @@
+package report
+
+import (
+	"bufio"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// a test2json event, see "go doc test2json"
+type goTestEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+// e.g. "    math_test.go:12: expected 3, got 4"
+var goTestLogRegexp = regexp.MustCompile(`^\s+(\S+\.go):(\d+): (.*)$`)
+
+/* parses the output of go test -json (i.e. test2json). Lines which aren't JSON are ignored. */
+func ParseGoTest(r io.Reader) (*Report, error) {
+	report := &Report{}
+	output := map[string]*strings.Builder{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		event := &goTestEvent{}
+		if json.Unmarshal(scanner.Bytes(), event) != nil || event.Test == "" {
+			continue
+		}
+		key := event.Package + "\x00" + event.Test
+		switch event.Action {
+		case "output":
+			if output[key] == nil {
+				output[key] = &strings.Builder{}
+			}
+			output[key].WriteString(event.Output)
+		case "pass", "fail", "skip":
+			test := &TestCase{
+				Name:     event.Test,
+				Suite:    event.Package,
+				Duration: time.Duration(event.Elapsed * float64(time.Second)),
+				Failed:   event.Action == "fail",
+				Skipped:  event.Action == "skip",
+			}
+			if event.Action == "fail" && output[key] != nil {
+				test.Details = strings.TrimSpace(output[key].String())
+				for _, line := range strings.Split(test.Details, "\n") {
+					if match := goTestLogRegexp.FindStringSubmatch(line); match != nil {
+						test.File, test.Line = findLocation(match[1] + ":" + match[2])
+						test.Message = match[3]
+						break
+					}
+				}
+				if test.Message == "" {
+					test.Message = firstLine(test.Details)
+				}
+			}
+			delete(output, key)
+			report.Tests = append(report.Tests, test)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "read go test output")
+	}
+	return report, nil
+}
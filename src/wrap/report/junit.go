@@ -0,0 +1,90 @@
+package report
+
+import (
+	"encoding/xml"
+	"github.com/pkg/errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type junitResult struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitTestCase struct {
+	Name      string       `xml:"name,attr"`
+	ClassName string       `xml:"classname,attr"`
+	File      string       `xml:"file,attr"`
+	Line      string       `xml:"line,attr"`
+	Time      string       `xml:"time,attr"`
+	Failure   *junitResult `xml:"failure"`
+	Error     *junitResult `xml:"error"`
+	Skipped   *junitResult `xml:"skipped"`
+}
+
+type junitTestSuite struct {
+	Name      string            `xml:"name,attr"`
+	File      string            `xml:"file,attr"`
+	TestCases []*junitTestCase  `xml:"testcase"`
+	Suites    []*junitTestSuite `xml:"testsuite"`
+}
+
+func parseSeconds(s string) time.Duration {
+	seconds, err := strconv.ParseFloat(strings.ReplaceAll(s, ",", ""), 64)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+func (suite *junitTestSuite) collect(report *Report) {
+	for _, tc := range suite.TestCases {
+		test := &TestCase{
+			Name:     tc.Name,
+			Suite:    coalesce(tc.ClassName, suite.Name),
+			File:     coalesce(tc.File, suite.File),
+			Duration: parseSeconds(tc.Time),
+			Skipped:  tc.Skipped != nil,
+		}
+		test.Line, _ = strconv.Atoi(tc.Line)
+		result := tc.Failure
+		if result == nil {
+			result = tc.Error
+		}
+		if result != nil {
+			test.Failed = true
+			test.Details = strings.TrimSpace(result.Text)
+			test.Message = coalesce(strings.TrimSpace(result.Message), firstLine(result.Text), result.Type)
+			// prefer the location of the failure over the location of the test
+			if file, line := findLocation(test.Details); file != "" {
+				test.File, test.Line = file, line
+			}
+		}
+		report.Tests = append(report.Tests, test)
+	}
+	for _, child := range suite.Suites {
+		child.collect(report)
+	}
+}
+
+/* parses JUnit XML, with either a <testsuites> or a <testsuite> root */
+func ParseJUnit(r io.Reader) (*Report, error) {
+	var root struct {
+		XMLName xml.Name
+		junitTestSuite
+	}
+	err := xml.NewDecoder(r).Decode(&root)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse junit xml")
+	}
+	if root.XMLName.Local != "testsuites" && root.XMLName.Local != "testsuite" {
+		return nil, errors.Errorf("unexpected junit root element <%v>", root.XMLName.Local)
+	}
+	report := &Report{}
+	root.collect(report)
+	return report, nil
+}
@@ -0,0 +1,109 @@
+/*
+Package report parses the test reports written by common test runners
+(JUnit XML, TAP and go test -json) into a common format.
+*/
+package report
+
+import (
+	"github.com/pkg/errors"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Format string
+
+const (
+	JUnit  Format = "junit"
+	TAP    Format = "tap"
+	GoTest Format = "gotest"
+)
+
+type TestCase struct {
+	Name  string
+	Suite string
+	// File and Line point at the failure if it could be determined, otherwise at the test
+	File     string
+	Line     int
+	Duration time.Duration
+	Failed   bool
+	Skipped  bool
+	// Message is a one-line summary of the failure, Details its full output
+	Message string
+	Details string
+}
+
+type Report struct {
+	Tests []*TestCase
+}
+
+func (r *Report) Failures() []*TestCase {
+	var failures []*TestCase
+	for _, test := range r.Tests {
+		if test.Failed {
+			failures = append(failures, test)
+		}
+	}
+	return failures
+}
+
+func (r *Report) Skipped() int {
+	n := 0
+	for _, test := range r.Tests {
+		if test.Skipped {
+			n++
+		}
+	}
+	return n
+}
+
+/* parses the report at path, which must be in the given format */
+func ParseFile(format Format, path string) (*Report, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open report")
+	}
+	defer f.Close()
+	switch format {
+	case JUnit:
+		return ParseJUnit(f)
+	case TAP:
+		return ParseTAP(f)
+	case GoTest:
+		return ParseGoTest(f)
+	}
+	return nil, errors.Errorf("unknown report format %q", format)
+}
+
+// matches locations like "src/foo_test.go:12" or "/app/test/foo.spec.js:12:5"
+var locationRegexp = regexp.MustCompile(`((?:[\w.@-]*/)*[\w.@-]+\.[A-Za-z]\w*):(\d+)`)
+
+/* returns the first file:line location mentioned in the given text */
+func findLocation(text string) (string, int) {
+	match := locationRegexp.FindStringSubmatch(text)
+	if match == nil {
+		return "", 0
+	}
+	line, _ := strconv.Atoi(match[2])
+	return match[1], line
+}
+
+func coalesce(args ...string) string {
+	for _, arg := range args {
+		if arg != "" {
+			return arg
+		}
+	}
+	return ""
+}
+
+func firstLine(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		if trimmed := strings.TrimSpace(line); trimmed != "" {
+			return trimmed
+		}
+	}
+	return ""
+}
@@ -0,0 +1,124 @@
+package report
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func assertEqual(t *testing.T, varName string, expected interface{}, got interface{}) {
+	if expected == got {
+		return
+	}
+	t.Fatalf("Expected %v \"%v\", got \"%v\"", varName, expected, got)
+}
+
+func TestParseJUnit(t *testing.T) {
+	report, err := ParseJUnit(strings.NewReader(`<?xml version="1.0"?>
+<testsuites>
+  <testsuite name="math">
+    <testcase name="adds" classname="MathTest" time="0.5"/>
+    <testcase name="divides" classname="MathTest" time="1.25">
+      <failure message="expected 2 but was 3">AssertionError
+    at MathTest.divides(src/test/MathTest.java:42)</failure>
+    </testcase>
+    <testsuite name="nested">
+      <testcase name="skips"><skipped/></testcase>
+    </testsuite>
+  </testsuite>
+</testsuites>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, "test count", 3, len(report.Tests))
+	assertEqual(t, "skipped", 1, report.Skipped())
+	failures := report.Failures()
+	assertEqual(t, "failure count", 1, len(failures))
+	assertEqual(t, "name", "divides", failures[0].Name)
+	assertEqual(t, "suite", "MathTest", failures[0].Suite)
+	assertEqual(t, "message", "expected 2 but was 3", failures[0].Message)
+	assertEqual(t, "file", "src/test/MathTest.java", failures[0].File)
+	assertEqual(t, "line", 42, failures[0].Line)
+	assertEqual(t, "duration", 1250*time.Millisecond, failures[0].Duration)
+}
+
+func TestParseTAP(t *testing.T) {
+	report, err := ParseTAP(strings.NewReader(`TAP version 13
+1..4
+ok 1 - adds
+not ok 2 - divides
+  ---
+  message: 'expected 2 but was 3'
+  at:
+    file: test/math.js
+    line: 12
+  duration_ms: 7
+  ...
+ok 3 - multiplies # SKIP no hardware
+not ok 4 - subtracts # TODO not written yet
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, "test count", 4, len(report.Tests))
+	assertEqual(t, "skipped", 1, report.Skipped())
+	failures := report.Failures()
+	assertEqual(t, "failure count", 1, len(failures))
+	assertEqual(t, "name", "divides", failures[0].Name)
+	assertEqual(t, "message", "expected 2 but was 3", failures[0].Message)
+	assertEqual(t, "file", "test/math.js", failures[0].File)
+	assertEqual(t, "line", 12, failures[0].Line)
+	assertEqual(t, "duration", 7*time.Millisecond, failures[0].Duration)
+}
+
+func TestParseGoTest(t *testing.T) {
+	report, err := ParseGoTest(strings.NewReader(`{"Action":"run","Package":"example.com/math","Test":"TestAdd"}
+{"Action":"pass","Package":"example.com/math","Test":"TestAdd","Elapsed":0.01}
+{"Action":"run","Package":"example.com/math","Test":"TestDivide"}
+{"Action":"output","Package":"example.com/math","Test":"TestDivide","Output":"=== RUN   TestDivide\n"}
+{"Action":"output","Package":"example.com/math","Test":"TestDivide","Output":"    math_test.go:12: expected 2, got 3\n"}
+{"Action":"fail","Package":"example.com/math","Test":"TestDivide","Elapsed":0.02}
+not json
+{"Action":"fail","Package":"example.com/math","Elapsed":0.03}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, "test count", 2, len(report.Tests))
+	failures := report.Failures()
+	assertEqual(t, "failure count", 1, len(failures))
+	assertEqual(t, "name", "TestDivide", failures[0].Name)
+	assertEqual(t, "suite", "example.com/math", failures[0].Suite)
+	assertEqual(t, "message", "expected 2, got 3", failures[0].Message)
+	assertEqual(t, "file", "math_test.go", failures[0].File)
+	assertEqual(t, "line", 12, failures[0].Line)
+}
+
+func TestGlob(t *testing.T) {
+	dir, err := ioutil.TempDir("", "Wrap.TestGlob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"junit.xml", "a/junit.xml", "a/b/junit.xml", "a/b/other.txt"} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	matches, err := Glob(dir + "/**/*.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, "match count", 3, len(matches))
+	matches, err = Glob(dir + "/a/*/*.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, "match count", 1, len(matches))
+}
@@ -0,0 +1,110 @@
+package report
+
+import (
+	"bufio"
+	"github.com/pkg/errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// e.g. "not ok 2 - adds numbers # TODO not implemented yet"
+var tapTestRegexp = regexp.MustCompile(`^(not ok|ok)\b\s*(\d+)?\s*(?:-\s*)?([^#]*?)\s*(?:#\s*(\w+)\b\s*(.*))?$`)
+
+// e.g. "  message: 'expected 1 to equal 2'" in a YAML diagnostic block
+var tapDiagnosticRegexp = regexp.MustCompile(`^\s*(message|error|file|line|location|duration_ms):\s*(.*)$`)
+
+func unquote(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+/* reads the YAML diagnostic block following a test, if any, into the test */
+func (test *TestCase) addTAPDiagnostics(lines []string) {
+	test.Details = strings.Join(lines, "\n")
+	for _, line := range lines {
+		match := tapDiagnosticRegexp.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		value := unquote(match[2])
+		switch match[1] {
+		case "message", "error":
+			if test.Message == "" && value != "" && value != "|-" && value != "|" {
+				test.Message = value
+			}
+		case "file":
+			test.File = value
+		case "line":
+			test.Line, _ = strconv.Atoi(value)
+		case "location":
+			test.File, test.Line = findLocation(value)
+		case "duration_ms":
+			ms, _ := strconv.ParseFloat(value, 64)
+			test.Duration = time.Duration(ms * float64(time.Millisecond))
+		}
+	}
+}
+
+/*
+Parses the Test Anything Protocol. Only top-level tests are reported,
+indented subtests roll up into their parents.
+*/
+func ParseTAP(r io.Reader) (*Report, error) {
+	report := &Report{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	var last *TestCase
+	var diagnostics []string
+	inDiagnostics := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if inDiagnostics {
+			if trimmed == "..." {
+				last.addTAPDiagnostics(diagnostics)
+				inDiagnostics = false
+				continue
+			}
+			diagnostics = append(diagnostics, line)
+			continue
+		}
+		if trimmed == "---" && last != nil && line != trimmed {
+			inDiagnostics = true
+			diagnostics = nil
+			continue
+		}
+		if strings.HasPrefix(trimmed, "Bail out!") {
+			report.Tests = append(report.Tests, &TestCase{
+				Name:    "Bail out!",
+				Failed:  true,
+				Message: strings.TrimSpace(strings.TrimPrefix(trimmed, "Bail out!")),
+			})
+			continue
+		}
+		match := tapTestRegexp.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		directive := strings.ToUpper(match[4])
+		last = &TestCase{
+			Name:    coalesce(match[3], "test "+match[2]),
+			Skipped: directive == "SKIP",
+			// failing TODO tests are expected to fail
+			Failed: match[1] == "not ok" && directive != "TODO" && directive != "SKIP",
+		}
+		if last.Skipped {
+			last.Message = match[5]
+		}
+		report.Tests = append(report.Tests, last)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "read tap")
+	}
+	return report, nil
+}